@@ -113,6 +113,169 @@ func calculateNDColMajor(base unsafe.Pointer, indices, dimensions []int, element
 	return unsafe.Pointer(uintptr(base) + uintptr(offset)*elementSize)
 }
 
+// h3 -- Index Validation Function
+// h4 -- Checks that indices address an element inside an array of the given dimensions
+// h6 -- Returns: nil, or an error describing the first mismatch or out-of-range index
+func validateIndices(indices, dimensions []int) error {
+	if len(indices) != len(dimensions) {
+		return fmt.Errorf("got %d indices for %d dimensions", len(indices), len(dimensions))
+	}
+	for d, idx := range indices {
+		if idx < 0 || idx >= dimensions[d] {
+			return fmt.Errorf("index %d out of range [0, %d) in dimension %d", idx, dimensions[d], d)
+		}
+	}
+	return nil
+}
+
+// h3 -- Checked N-Dimensional Offset (Row-Major)
+// h4 -- Validating counterpart of calculateNDRowMajor that returns an element offset
+// h5 -- indices: slice containing indices for each dimension
+// h5 -- dimensions: slice containing sizes of each dimension
+// h6 -- Returns: flat element offset (multiply by the element size for bytes), or an error
+// h6 -- Note: Horner-style accumulation - offset = offset*dim + index, left to right
+func OffsetRowMajor(indices, dimensions []int) (int, error) {
+	if err := validateIndices(indices, dimensions); err != nil {
+		return 0, err
+	}
+	offset := 0
+	for d, idx := range indices {
+		offset = offset*dimensions[d] + idx
+	}
+	return offset, nil
+}
+
+// h3 -- Checked N-Dimensional Offset (Column-Major)
+// h4 -- Validating counterpart of calculateNDColMajor; accumulates right to left
+// h6 -- Returns: flat element offset, or an error
+func OffsetColMajor(indices, dimensions []int) (int, error) {
+	if err := validateIndices(indices, dimensions); err != nil {
+		return 0, err
+	}
+	offset := 0
+	for d := len(indices) - 1; d >= 0; d-- {
+		offset = offset*dimensions[d] + indices[d]
+	}
+	return offset, nil
+}
+
+// h3 -- Inverse Index Mapping
+// h4 -- Reconstructs per-dimension indices from a flat offset
+// h5 -- offset: flat element offset in [0, product of dimensions)
+// h5 -- dimensions: slice containing sizes of each dimension
+// h5 -- rowMajor: layout the offset was computed with
+// h6 -- Returns: indices such that Offset*(indices, dimensions) == offset
+// h6 -- Panics: when offset is outside the array
+func Unflatten(offset int, dimensions []int, rowMajor bool) []int {
+	total := 1
+	for _, dim := range dimensions {
+		total *= dim
+	}
+	if offset < 0 || offset >= total {
+		panic(fmt.Sprintf("offset %d out of range [0, %d)", offset, total))
+	}
+
+	// Peel off the fastest-varying dimension first: the last for row-major, the first for column-major
+	indices := make([]int, len(dimensions))
+	for step := range dimensions {
+		d := len(dimensions) - 1 - step
+		if !rowMajor {
+			d = step
+		}
+		indices[d] = offset % dimensions[d]
+		offset /= dimensions[d]
+	}
+	return indices
+}
+
+// h3 -- Matrix Type
+// h4 -- Two-dimensional float32 matrix stored row-major in one flat slice
+// h5 -- Rows, Cols: Dimensions, fixed at construction
+// h5 -- data: Element (i, j) lives at data[i*Cols + j]
+type Matrix struct {
+	Rows, Cols int
+	data       []float32
+}
+
+// h3 -- Matrix Constructor
+// h6 -- Returns: Zero-filled rows x cols matrix
+// h6 -- Panics: when a dimension is negative
+func NewMatrix(rows, cols int) Matrix {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("matrix dimensions must be non-negative, got %dx%d", rows, cols))
+	}
+	return Matrix{Rows: rows, Cols: cols, data: make([]float32, rows*cols)}
+}
+
+// h3 -- Matrix Bounds Check
+// h4 -- Rejects (i, j) outside the matrix; a bad column would otherwise alias the next row
+func (m Matrix) index(i, j int) int {
+	if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+		panic(fmt.Sprintf("matrix index (%d, %d) out of range for %dx%d matrix", i, j, m.Rows, m.Cols))
+	}
+	return i*m.Cols + j
+}
+
+// h3 -- Matrix Element Access
+// h6 -- Panics: on out-of-range indices
+func (m Matrix) At(i, j int) float32     { return m.data[m.index(i, j)] }
+func (m Matrix) Set(i, j int, v float32) { m.data[m.index(i, j)] = v }
+
+// h3 -- Transpose Function
+// h4 -- Returns a new Cols x Rows matrix with element (i, j) moved to (j, i)
+// h6 -- Time Complexity: O(rows * cols)
+func (m Matrix) Transpose() Matrix {
+	t := NewMatrix(m.Cols, m.Rows)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			t.data[j*t.Cols+i] = m.data[i*m.Cols+j]
+		}
+	}
+	return t
+}
+
+// h3 -- Traversal Sums
+// h4 -- Same total visited in memory order (row-major) or with a stride of Cols (column-major)
+// h6 -- Row-major walks consecutive addresses; column-major jumps Cols*4 bytes per step,
+// h6 -- touching a new cache line almost every access once a row exceeds the line size
+// h6 -- Note: Four accumulators break the dependency chain of float additions; with a single
+// h6 --       sum both loops run at add latency and the memory difference is hidden
+func (m Matrix) SumRowMajor() float32 {
+	var s0, s1, s2, s3 float32
+	for i := 0; i < m.Rows; i++ {
+		row := m.data[i*m.Cols : (i+1)*m.Cols]
+		j := 0
+		for ; j+3 < len(row); j += 4 {
+			s0 += row[j]
+			s1 += row[j+1]
+			s2 += row[j+2]
+			s3 += row[j+3]
+		}
+		for ; j < len(row); j++ {
+			s0 += row[j]
+		}
+	}
+	return s0 + s1 + s2 + s3
+}
+
+func (m Matrix) SumColMajor() float32 {
+	var s0, s1, s2, s3 float32
+	c := m.Cols
+	for j := 0; j < c; j++ {
+		i := 0
+		for ; i+3 < m.Rows; i += 4 {
+			s0 += m.data[i*c+j]
+			s1 += m.data[(i+1)*c+j]
+			s2 += m.data[(i+2)*c+j]
+			s3 += m.data[(i+3)*c+j]
+		}
+		for ; i < m.Rows; i++ {
+			s0 += m.data[i*c+j]
+		}
+	}
+	return s0 + s1 + s2 + s3
+}
+
 // h3 -- 2D Array Print Function
 // h4 -- Utility function to print the contents of a 2D array stored in a flat slice
 // h5 -- arr: flat slice containing the 2D array data
@@ -177,14 +340,19 @@ func main() {
 		}
 	}
 
-	// h4 -- Convert to flat slice for printing and display array contents
-	sliceB := make([]float32, ROWS*COLS)
+	// h4 -- Copy into a Matrix for printing and display array contents
+	matB := NewMatrix(ROWS, COLS)
 	for i := 0; i < ROWS; i++ {
 		for j := 0; j < COLS; j++ {
-			sliceB[i*COLS+j] = B[i][j]
+			matB.Set(i, j, B[i][j])
 		}
 	}
-	printArray2D(sliceB, ROWS, COLS)
+	printArray2D(matB.data, matB.Rows, matB.Cols)
+
+	// h4 -- Transposing swaps the dimensions; the flat layout is still row-major
+	transB := matB.Transpose()
+	fmt.Printf("\nTranspose (%d x %d):\n", transB.Rows, transB.Cols)
+	printArray2D(transB.data, transB.Rows, transB.Cols)
 
 	fmt.Printf("\nBase address: %p\n", &B)
 	fmt.Printf("Dimensions: %d x %d\n", ROWS, COLS)
@@ -289,11 +457,10 @@ func main() {
 	fmt.Printf("]\n")
 
 	// h4 -- Calculate actual index in flat array
-	actualIndex := 0
-	multiplier := 1
-	for d := len(dimensions) - 1; d >= 0; d-- {
-		actualIndex += testIndices[d] * multiplier
-		multiplier *= dimensions[d]
+	actualIndex, err := OffsetRowMajor(testIndices, dimensions)
+	if err != nil {
+		fmt.Println("Invalid indices:", err)
+		return
 	}
 
 	fmt.Printf("Actual address:    %p\n", &D[actualIndex])
@@ -309,6 +476,16 @@ func main() {
 	fmt.Printf("Column-major calc: %p\n", colMajorND)
 	fmt.Printf("Column-major value: %.1f\n", *(*float32)(colMajorND))
 
+	// h4 -- Checked offsets and the inverse mapping
+	colIndex, _ := OffsetColMajor(testIndices, dimensions)
+	fmt.Printf("\nChecked offsets:   row-major %d, column-major %d\n", actualIndex, colIndex)
+	fmt.Printf("Unflatten:         row-major %v, column-major %v\n",
+		Unflatten(actualIndex, dimensions, true), Unflatten(colIndex, dimensions, false))
+	for _, bad := range [][]int{{1, 2, 3}, {1, 3, 0, 0}, {0, -1, 0, 0}} {
+		_, err := OffsetRowMajor(bad, dimensions)
+		fmt.Printf("Offset of %v: %v\n", bad, err)
+	}
+
 	// h3 -- Section 5: Access Pattern Demonstration
 	// h4 -- Shows memory layout patterns for better understanding
 	fmt.Println("\n\n5. ACCESS PATTERN DEMONSTRATION")
@@ -364,4 +541,5 @@ func main() {
 	fmt.Println("  - Performance depends on access patterns:")
 	fmt.Println("    * Row-major: Efficient for row-wise access")
 	fmt.Println("    * Column-major: Efficient for column-wise access")
+	fmt.Println("  - Measure it: go test -bench=MatrixSum array_addr.go array_addr_test.go")
 }
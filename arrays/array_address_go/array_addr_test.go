@@ -0,0 +1,158 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOffsetMatchesAddressCalculation(t *testing.T) {
+	dimensions := []int{2, 3, 4, 2}
+	indices := []int{1, 2, 3, 1}
+
+	row, err := OffsetRowMajor(indices, dimensions)
+	if err != nil || row != 47 {
+		t.Errorf("OffsetRowMajor = (%d, %v), want (47, nil)", row, err)
+	}
+	col, err := OffsetColMajor(indices, dimensions)
+	if err != nil || col != 1+2*2+3*6+1*24 {
+		t.Errorf("OffsetColMajor = (%d, %v), want (%d, nil)", col, err, 1+2*2+3*6+1*24)
+	}
+}
+
+func TestOffsetErrors(t *testing.T) {
+	dimensions := []int{2, 3}
+	tests := []struct {
+		name    string
+		indices []int
+	}{
+		{"too few indices", []int{1}},
+		{"too many indices", []int{1, 1, 1}},
+		{"index equals dimension", []int{2, 0}},
+		{"negative index", []int{0, -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := OffsetRowMajor(tt.indices, dimensions); err == nil {
+				t.Errorf("OffsetRowMajor(%v) returned no error", tt.indices)
+			}
+			if _, err := OffsetColMajor(tt.indices, dimensions); err == nil {
+				t.Errorf("OffsetColMajor(%v) returned no error", tt.indices)
+			}
+		})
+	}
+}
+
+func TestUnflattenRoundTrip(t *testing.T) {
+	shapes := [][]int{
+		{7},       // 1-D degenerate case: offset equals the index
+		{3, 4},    // 2-D
+		{2, 3, 4}, // 3-D
+		{2, 1, 3, 2},
+	}
+	for _, dims := range shapes {
+		total := 1
+		for _, d := range dims {
+			total *= d
+		}
+		for _, rowMajor := range []bool{true, false} {
+			offset := OffsetRowMajor
+			if !rowMajor {
+				offset = OffsetColMajor
+			}
+			for want := 0; want < total; want++ {
+				idx := Unflatten(want, dims, rowMajor)
+				got, err := offset(idx, dims)
+				if err != nil || got != want {
+					t.Fatalf("dims %v rowMajor %t: Unflatten(%d) = %v, offset back = (%d, %v)",
+						dims, rowMajor, want, idx, got, err)
+				}
+			}
+		}
+	}
+	if got := Unflatten(5, []int{7}, true); !slices.Equal(got, []int{5}) {
+		t.Errorf("1-D Unflatten(5) = %v, want [5]", got)
+	}
+}
+
+func TestMatrixTransposeNonSquare(t *testing.T) {
+	m := NewMatrix(2, 3)
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			m.Set(i, j, float32(10*i+j))
+		}
+	}
+	tr := m.Transpose()
+	if tr.Rows != 3 || tr.Cols != 2 {
+		t.Fatalf("Transpose of 2x3 is %dx%d, want 3x2", tr.Rows, tr.Cols)
+	}
+	for i := 0; i < m.Rows; i++ {
+		for j := 0; j < m.Cols; j++ {
+			if tr.At(j, i) != m.At(i, j) {
+				t.Errorf("Transpose.At(%d, %d) = %v, want %v", j, i, tr.At(j, i), m.At(i, j))
+			}
+		}
+	}
+	if back := tr.Transpose(); !slices.Equal(back.data, m.data) {
+		t.Errorf("double transpose = %v, want %v", back.data, m.data)
+	}
+}
+
+func TestMatrixOutOfRangePanics(t *testing.T) {
+	m := NewMatrix(2, 3)
+	cases := [][2]int{{0, 3}, {2, 0}, {-1, 0}, {0, -1}} // (0, 3) would alias (1, 0) unchecked
+	for _, c := range cases {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("At(%d, %d) did not panic", c[0], c[1])
+				}
+			}()
+			m.At(c[0], c[1])
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Set(%d, %d) did not panic", c[0], c[1])
+				}
+			}()
+			m.Set(c[0], c[1], 1)
+		}()
+	}
+}
+
+func BenchmarkMatrixSum(b *testing.B) {
+	const size = 4096
+	m := NewMatrix(size, size)
+	for i := range m.data {
+		m.data[i] = 1
+	}
+	b.ResetTimer()
+
+	b.Run("row-major", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.SumRowMajor()
+		}
+	})
+	b.Run("column-major", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.SumColMajor()
+		}
+	})
+}
+
+func TestMatrixSumsAgree(t *testing.T) {
+	for _, dims := range [][2]int{{0, 0}, {1, 1}, {3, 5}, {7, 2}, {8, 8}} {
+		m := NewMatrix(dims[0], dims[1])
+		var want float32
+		for i := range m.data {
+			m.data[i] = float32(i % 7)
+			want += m.data[i]
+		}
+		if got := m.SumRowMajor(); got != want {
+			t.Errorf("%v SumRowMajor = %v, want %v", dims, got, want)
+		}
+		if got := m.SumColMajor(); got != want {
+			t.Errorf("%v SumColMajor = %v, want %v", dims, got, want)
+		}
+	}
+}
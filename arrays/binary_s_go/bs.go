@@ -1,23 +1,26 @@
 // h1 -- Binary Search Algorithm Implementation in Go
 // h2 -- Efficient search for sorted slices using divide and conquer
-// h2 -- Includes performance benchmarking and comprehensive testing
+// h2 -- Benchmarks and unit tests live in bs_test.go
 
 package main
 
 import (
+	"cmp"
 	"fmt"
-	"time"
+	"math"
+	"math/rand"
 )
 
 // h3 -- Binary Search Function
 // h4 -- Searches for target in sorted slice using iterative approach
-// h5 -- arr: Sorted slice of integers to search through
+// h5 -- arr: Sorted slice of any ordered type to search through
 // h5 -- target: Value to search for
 // h6 -- Returns: Index of target if found, -1 if not found
 // h6 -- Time Complexity: O(log n) - logarithmic time
 // h6 -- Space Complexity: O(1) - constant space
-// h6 -- Note: Slice must be sorted in ascending order
-func binarySearch(arr []int, target int) int {
+// h6 -- Note: Slice must be sorted in ascending order; with duplicates any
+// h6 --       matching index may be returned (see LowerBound/UpperBound)
+func BinarySearch[T cmp.Ordered](arr []T, target T) int {
 	low := 0
 	high := len(arr) - 1
 
@@ -36,59 +39,480 @@ func binarySearch(arr []int, target int) int {
 	return -1 // Not found
 }
 
-// h3 -- Performance Test Function
-// h4 -- Tests binary search performance with large sorted slices
-// h5 -- size: Size of test slice to generate
-// h6 -- Uses Go's time package for precise timing
-func performanceTest(size int) {
-	if size <= 0 {
-		fmt.Printf("Invalid size for performance test: %d\n", size)
-		return
+// h3 -- Lower Bound Function
+// h4 -- Finds the first index whose element is not less than target
+// h5 -- arr: Sorted slice to search through
+// h5 -- target: Value to locate
+// h6 -- Returns: Index of the first occurrence of target, or the insertion point
+// h6 --          when absent (len(arr) if target is larger than every element)
+// h6 -- Time Complexity: O(log n), Space Complexity: O(1)
+func LowerBound[T cmp.Ordered](arr []T, target T) int {
+	low := 0
+	high := len(arr) // Half-open range [low, high)
+
+	for low < high {
+		mid := low + (high-low)/2
+		if arr[mid] < target {
+			low = mid + 1 // First occurrence is right of mid
+		} else {
+			high = mid // mid may be the first occurrence
+		}
+	}
+	return low
+}
+
+// h3 -- Upper Bound Function
+// h4 -- Finds the last occurrence of target
+// h5 -- arr: Sorted slice to search through
+// h5 -- target: Value to locate
+// h6 -- Returns: Index of the last occurrence of target, or the insertion point
+// h6 --          when absent (the same index LowerBound returns)
+// h6 -- Time Complexity: O(log n), Space Complexity: O(1)
+// h6 -- Note: When present, [LowerBound, UpperBound] is the inclusive run of target
+func UpperBound[T cmp.Ordered](arr []T, target T) int {
+	low := 0
+	high := len(arr)
+
+	// Find the first element greater than target
+	for low < high {
+		mid := low + (high-low)/2
+		if arr[mid] <= target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low > 0 && arr[low-1] == target {
+		return low - 1 // Last occurrence sits just before it
+	}
+	return low
+}
+
+// h3 -- Jump Search Function
+// h4 -- Jumps ahead in blocks of √n until a block may contain target, then scans it
+// h5 -- arr: Slice sorted in ascending order
+// h5 -- target: Value to search for
+// h6 -- Returns: Index of target if found (the first occurrence), -1 if not found
+// h6 -- Time Complexity: O(√n), Space Complexity: O(1)
+// h6 -- Note: Only moves forward, which suits media where stepping back is costly
+func JumpSearch(arr []int, target int) int {
+	n := len(arr)
+	step := max(1, int(math.Sqrt(float64(n))))
+
+	// Skip whole blocks whose last element is still smaller than target
+	low := 0
+	for low < n && arr[min(low+step, n)-1] < target {
+		low += step
+	}
+
+	// Linear scan within the block that may hold target
+	for i := low; i < min(low+step, n); i++ {
+		if arr[i] == target {
+			return i
+		}
+		if arr[i] > target {
+			break
+		}
+	}
+	return -1
+}
+
+// h3 -- Interpolation Search Function
+// h4 -- Probes where target would sit if values grew linearly between low and high
+// h5 -- arr: Slice sorted in ascending order
+// h5 -- target: Value to search for
+// h6 -- Returns: Index of target if found, -1 if not found
+// h6 -- Time Complexity: O(log log n) on uniformly distributed keys, O(n) worst case
+// h6 -- Space Complexity: O(1)
+// h6 -- Note: The estimate is computed in float64 so large differences cannot overflow; values
+// h6 --       above 2^53 can round to the same float64, so a zero float span falls back to
+// h6 --       the midpoint and the probe is always clamped into [low, high]
+func InterpolationSearch(arr []int, target int) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high && target >= arr[low] && target <= arr[high] {
+		if arr[high] == arr[low] {
+			// Flat range: every element equals arr[low]; avoids dividing by zero
+			if arr[low] == target {
+				return low
+			}
+			return -1
+		}
+
+		// Linear estimate of target's position within [low, high]
+		pos := low + (high-low)/2
+		if span := float64(arr[high]) - float64(arr[low]); span != 0 {
+			fraction := (float64(target) - float64(arr[low])) / span
+			pos = low + int(fraction*float64(high-low))
+		}
+		pos = min(max(pos, low), high)
+
+		if arr[pos] == target {
+			return pos
+		} else if arr[pos] < target {
+			low = pos + 1
+		} else {
+			high = pos - 1
+		}
+	}
+	return -1
+}
+
+// h3 -- Sorted Position Search Function
+// h4 -- Finds the first index whose element is not less than v (insertion point)
+// h5 -- arr: Slice sorted according to less
+// h5 -- v: Value to locate
+// h5 -- less: Strict ordering used to sort arr
+// h6 -- Returns: Index in [0, len(arr)] where v belongs
+// h6 -- Time Complexity: O(log n), Space Complexity: O(1)
+func sortedPosition[T any](arr []T, v T, less func(a, b T) bool) int {
+	low := 0
+	high := len(arr) // Half-open range [low, high)
+
+	for low < high {
+		mid := low + (high-low)/2
+		if less(arr[mid], v) {
+			low = mid + 1 // v belongs to the right of mid
+		} else {
+			high = mid // mid may be the insertion point
+		}
+	}
+	return low
+}
+
+// h3 -- Sorted Insert Function
+// h4 -- Inserts v at the position found by binary search, keeping the slice sorted
+// h5 -- arr: Slice sorted according to less
+// h5 -- v: Value to insert
+// h5 -- less: Strict ordering used to sort arr
+// h6 -- Returns: Slice containing v (may reuse arr's backing array)
+// h6 -- Time Complexity: O(log n) search + O(n) shift
+// h6 -- Note: Equal values are inserted after existing ones (stable)
+func insertSorted[T any](arr []T, v T, less func(a, b T) bool) []T {
+	// Skip past elements equal to v so insertion order is preserved
+	pos := sortedPosition(arr, v, func(a, b T) bool { return !less(b, a) })
+
+	var zero T
+	arr = append(arr, zero)
+	copy(arr[pos+1:], arr[pos:])
+	arr[pos] = v
+	return arr
+}
+
+// h3 -- Sorted Remove Function
+// h4 -- Removes the first occurrence of v located via binary search
+// h5 -- arr: Slice sorted according to less
+// h5 -- v: Value to remove
+// h5 -- less: Strict ordering used to sort arr
+// h6 -- Returns: Slice without v and whether an element was removed
+// h6 -- Time Complexity: O(log n) search + O(n) shift
+func removeSorted[T any](arr []T, v T, less func(a, b T) bool) ([]T, bool) {
+	pos := sortedPosition(arr, v, less)
+	if pos == len(arr) || less(v, arr[pos]) {
+		return arr, false // Not present
+	}
+
+	copy(arr[pos:], arr[pos+1:])
+	var zero T
+	arr[len(arr)-1] = zero // Drop the stale reference for the garbage collector
+	return arr[:len(arr)-1], true
+}
+
+// h3 -- Continuous Binary Search Function
+// h4 -- Finds where a monotonic predicate flips from false to true on [lo, hi]
+// h5 -- lo, hi: Range bounds, with pred(lo) false and pred(hi) true
+// h5 -- pred: Monotonic predicate (false ... false true ... true)
+// h5 -- iterations: Number of halvings; precision is (hi-lo) / 2^iterations
+// h6 -- Returns: Upper end of the final bracket, so pred holds at the result
+// h6 -- Time Complexity: O(iterations) predicate calls
+// h6 -- Note: A fixed iteration count avoids float equality tests and always terminates
+func searchFloat(lo, hi float64, pred func(float64) bool, iterations int) float64 {
+	for i := 0; i < iterations; i++ {
+		mid := lo + (hi-lo)/2
+		if pred(mid) {
+			hi = mid // Boundary is at or left of mid
+		} else {
+			lo = mid // Boundary is right of mid
+		}
+	}
+	return hi
+}
+
+// h3 -- Integer Boundary Search Function
+// h4 -- Finds the smallest x in [lo, hi] for which a monotonic predicate holds
+// h5 -- lo, hi: Range bounds, with pred(hi) true
+// h5 -- pred: Monotonic predicate (false ... false true ... true)
+// h6 -- Returns: First x where pred(x) is true
+// h6 -- Time Complexity: O(log(hi-lo)) predicate calls
+// h6 -- Note: Basis of "binary search on the answer" - pred tests whether x is achievable
+func searchBoundary(lo, hi int, pred func(int) bool) int {
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if pred(mid) {
+			hi = mid // mid works; the boundary is at or left of it
+		} else {
+			lo = mid + 1 // mid fails; the boundary is right of it
+		}
+	}
+	return lo
+}
+
+// h3 -- Minimum Maximum Partition Function (Book Allocation)
+// h4 -- Splits arr into at most k contiguous groups minimizing the largest group sum
+// h5 -- arr: Non-negative values (e.g. page counts)
+// h5 -- k: Number of groups (readers); must be positive
+// h6 -- Returns: Smallest achievable maximum group sum (0 for an empty slice)
+// h6 -- Time Complexity: O(n log(sum)), Space Complexity: O(1)
+// h6 -- Note: A cap is feasible when greedily filling groups up to it needs at most k
+// h6 --       groups; feasibility is monotonic in the cap, so searchBoundary applies
+func minMaxPartition(arr []int, k int) int {
+	if k <= 0 {
+		panic(fmt.Sprintf("minMaxPartition: k must be positive, got %d", k))
+	}
+	largest, total := 0, 0
+	for _, v := range arr {
+		largest = max(largest, v)
+		total += v
+	}
+
+	feasible := func(limit int) bool {
+		groups, current := 1, 0
+		for _, v := range arr {
+			if current+v > limit {
+				groups++
+				current = 0
+			}
+			current += v
+		}
+		return groups <= k
+	}
+	// No group can be smaller than the largest element or larger than the total
+	return searchBoundary(largest, total, feasible)
+}
+
+// h3 -- Dynamic Programming Partition Reference
+// h4 -- best[j][i]: optimum for the first i elements split into j groups
+// h6 -- Time Complexity: O(k * n²) - reference for small inputs only
+func dpMinMaxPartition(arr []int, k int) int {
+	n := len(arr)
+	prefix := make([]int, n+1)
+	for i, v := range arr {
+		prefix[i+1] = prefix[i] + v
+	}
+	best := prefix // One group: the whole prefix
+	for j := 2; j <= k; j++ {
+		next := make([]int, n+1)
+		for i := 1; i <= n; i++ {
+			next[i] = best[i]
+			for split := 1; split < i; split++ {
+				next[i] = min(next[i], max(best[split], prefix[i]-prefix[split]))
+			}
+		}
+		best = next
 	}
+	return best[n]
+}
 
-	// Create slice with sorted sequential values
-	largeArr := make([]int, size)
-	for i := 0; i < size; i++ {
-		largeArr[i] = i * 2 // Even numbers for predictable searching
+// h3 -- Kth Smallest in Sorted Matrix Function
+// h4 -- Binary searches the value range for the smallest v with at least k elements <= v
+// h5 -- matrix: Rectangular matrix with every row and every column sorted ascending
+// h5 -- k: 1-based rank; panics unless 1 <= k <= rows*cols
+// h6 -- Returns: The k-th smallest element (duplicates counted separately)
+// h6 -- Time Complexity: O((rows + cols) * log(max - min)), Space Complexity: O(1)
+// h6 -- Note: The answer is always a matrix element - it is the first value whose count
+// h6 --       reaches k, and the count only changes at element values
+func kthSmallestInMatrix(matrix [][]int, k int) int {
+	rows := len(matrix)
+	if rows == 0 || k < 1 || k > rows*len(matrix[0]) {
+		panic(fmt.Sprintf("kthSmallestInMatrix: rank %d out of range", k))
+	}
+	cols := len(matrix[0])
+
+	// Staircase walk from the bottom-left corner counting elements <= v
+	countAtMost := func(v int) int {
+		count := 0
+		row, col := rows-1, 0
+		for row >= 0 && col < cols {
+			if matrix[row][col] <= v {
+				count += row + 1 // Everything above in this column is smaller too
+				col++
+			} else {
+				row--
+			}
+		}
+		return count
 	}
+	return searchBoundary(matrix[0][0], matrix[rows-1][cols-1],
+		func(v int) bool { return countAtMost(v) >= k })
+}
 
-	// Test different target positions
-	targets := []int{
-		largeArr[0],      // Best case: first element
-		largeArr[size/2], // Average case: middle element
-		largeArr[size-1], // Worst case: last element
-		-1,               // Not found case
+// h3 -- Staircase Matrix Search Function (Search a 2D Matrix II)
+// h4 -- Starts at the top-right corner and discards a row or a column per step
+// h5 -- matrix: Rectangular matrix with every row and every column sorted ascending
+// h6 -- The matrix need not be sorted as a whole (row r+1 may start below row r's end),
+// h6 -- so binary searching its row-major flattening would be wrong
+// h6 -- Returns: (row, col) of an occurrence of target, or (-1, -1)
+// h6 -- Time Complexity: O(rows + cols), Space Complexity: O(1)
+func searchMatrixII(matrix [][]int, target int) (int, int) {
+	if len(matrix) == 0 {
+		return -1, -1
 	}
-	cases := []string{"best", "average", "worst", "not found"}
+	row, col := 0, len(matrix[0])-1
+	for row < len(matrix) && col >= 0 {
+		switch v := matrix[row][col]; {
+		case v == target:
+			return row, col
+		case v > target:
+			col-- // Everything below in this column is larger still
+		default:
+			row++ // Everything left in this row is smaller still
+		}
+	}
+	return -1, -1
+}
 
-	fmt.Printf("Performance Test (Size: %d):\n", size)
+// h3 -- Bitonic Peak Function
+// h4 -- Finds the maximum of an increasing-then-decreasing slice by comparing mid with mid+1
+// h5 -- arr: Bitonic slice (strictly increasing, then strictly decreasing; either part may be empty)
+// h6 -- Returns: Index of the peak, -1 for an empty slice
+// h6 -- Time Complexity: O(log n), Space Complexity: O(1)
+func findPeak(arr []int) int {
+	if len(arr) == 0 {
+		return -1
+	}
+	low := 0
+	high := len(arr) - 1
+
+	for low < high {
+		mid := low + (high-low)/2
+		if arr[mid] < arr[mid+1] {
+			low = mid + 1 // Still climbing: peak is right of mid
+		} else {
+			high = mid // Descending: peak is mid or left of it
+		}
+	}
+	return low
+}
+
+// h3 -- Descending Binary Search Function
+// h4 -- BinarySearch for slices sorted in descending order
+func binarySearchDescending(arr []int, target int) int {
+	low := 0
+	high := len(arr) - 1
+
+	for low <= high {
+		mid := low + (high-low)/2
+
+		if arr[mid] == target {
+			return mid
+		} else if arr[mid] > target {
+			low = mid + 1 // Smaller values are to the right
+		} else {
+			high = mid - 1
+		}
+	}
+	return -1
+}
+
+// h3 -- Bitonic Search Function
+// h4 -- Locates the peak, then binary-searches the ascending and descending parts
+// h5 -- arr: Bitonic slice as accepted by findPeak
+// h6 -- Returns: Index of target if found, -1 if not found
+// h6 -- Time Complexity: O(log n) - three logarithmic passes
+func searchBitonic(arr []int, target int) int {
+	peak := findPeak(arr)
+	if peak == -1 {
+		return -1
+	}
+	if index := BinarySearch(arr[:peak+1], target); index != -1 {
+		return index
+	}
+	if index := binarySearchDescending(arr[peak+1:], target); index != -1 {
+		return peak + 1 + index
+	}
+	return -1
+}
 
-	// Warm up the function
-	for i := 0; i < 10; i++ {
-		binarySearch(largeArr, largeArr[size/2])
+// h3 -- Rotated Minimum Function (Duplicates Allowed)
+// h4 -- Finds the minimum of a rotated ascending slice that may contain repeats
+// h5 -- arr: Non-empty ascending slice rotated by an unknown amount
+// h6 -- Returns: Minimum value
+// h6 -- Time Complexity: O(log n) typical, O(n) worst case (e.g. all values equal)
+// h6 -- Note: When arr[mid] == arr[high] the minimum's side is unknown, but dropping
+// h6 --       high is safe because arr[mid] keeps an equal value in range
+func findMinWithDuplicates(arr []int) int {
+	if len(arr) == 0 {
+		panic("findMinWithDuplicates: empty slice has no minimum")
 	}
+	low := 0
+	high := len(arr) - 1
 
-	// Test each case with multiple iterations
-	const iterations = 10000
-	for t, target := range targets {
-		totalDuration := time.Duration(0)
-		foundCount := 0
+	for low < high {
+		mid := low + (high-low)/2
+		if arr[mid] > arr[high] {
+			low = mid + 1 // Rotation point is right of mid
+		} else if arr[mid] < arr[high] {
+			high = mid // mid..high is sorted; minimum is at mid or left
+		} else {
+			high-- // Ambiguous: shrink by one
+		}
+	}
+	return arr[low]
+}
 
-		for iter := 0; iter < iterations; iter++ {
-			start := time.Now()
-			result := binarySearch(largeArr, target)
-			elapsed := time.Since(start)
-			totalDuration += elapsed
+// h3 -- Search Function Type
+// h4 -- Common signature of the sorted-slice search variants
+type searchFunc func(arr []int, target int) int
+
+// h3 -- Differential Cross-Check Function
+// h4 -- Runs two search implementations on the same random sorted inputs
+// h5 -- a, b: Implementations under comparison
+// h5 -- trials: Number of random slices to generate
+// h5 -- size: Length of each slice (values repeat, so duplicates are exercised)
+// h5 -- seed: RNG seed, making any reported mismatch reproducible
+// h6 -- Returns: nil when the implementations agree, otherwise the first mismatch
+// h6 -- Note: Indices may differ on duplicates; both must then point at the target
+func crossCheck(a, b searchFunc, trials, size int, seed int64) error {
+	rng := rand.New(rand.NewSource(seed))
+
+	for trial := 0; trial < trials; trial++ {
+		arr := make([]int, size)
+		value := rng.Intn(3)
+		for i := range arr {
+			arr[i] = value
+			value += rng.Intn(3) // Steps of 0 create duplicate runs
+		}
 
-			if result != -1 {
-				foundCount++
+		// Probe every stored value plus the gaps and both ends
+		for target := -1; target <= value+1; target++ {
+			ia, ib := a(arr, target), b(arr, target)
+			foundA := ia >= 0 && ia < len(arr) && arr[ia] == target
+			foundB := ib >= 0 && ib < len(arr) && arr[ib] == target
+			if foundA != foundB || (!foundA && (ia != -1 || ib != -1)) {
+				return fmt.Errorf("trial %d (seed %d, size %d): target %d gave %d vs %d",
+					trial, seed, size, target, ia, ib)
 			}
 		}
+	}
+	return nil
+}
 
-		avgDuration := totalDuration / time.Duration(iterations)
-		fmt.Printf("  %s case: %v (success: %d/%d)\n",
-			cases[t], avgDuration, foundCount, iterations)
+// h3 -- Lower-Bound Search Function
+// h4 -- Independent search built on sortedPosition, used as a cross-check reference
+func lowerBoundSearch(arr []int, target int) int {
+	pos := sortedPosition(arr, target, func(a, b int) bool { return a < b })
+	if pos < len(arr) && arr[pos] == target {
+		return pos
 	}
+	return -1
+}
+
+// h3 -- Broken Binary Search Function
+// h4 -- Deliberately wrong variant that never inspects the last element
+// h6 -- Exists only to show crossCheck catching an off-by-one bug
+func brokenBinarySearch(arr []int, target int) int {
+	return BinarySearch(arr[:max(len(arr)-1, 0)], target)
 }
 
 // h3 -- Validation Test Function
@@ -98,39 +522,73 @@ func validationTests() {
 
 	// Test case 1: Normal sorted slice
 	arr1 := []int{2, 4, 6, 8, 10, 12, 14}
-	result1 := binarySearch(arr1, 10)
+	result1 := BinarySearch(arr1, 10)
 	fmt.Printf("  Search for 10 in %v: index %d (expected: 4)\n", arr1, result1)
 
 	// Test case 2: First element
-	result2 := binarySearch(arr1, 2)
+	result2 := BinarySearch(arr1, 2)
 	fmt.Printf("  Search for 2 (first element): index %d (expected: 0)\n", result2)
 
 	// Test case 3: Last element
-	result3 := binarySearch(arr1, 14)
+	result3 := BinarySearch(arr1, 14)
 	fmt.Printf("  Search for 14 (last element): index %d (expected: 6)\n", result3)
 
 	// Test case 4: Not found
-	result4 := binarySearch(arr1, 5)
+	result4 := BinarySearch(arr1, 5)
 	fmt.Printf("  Search for 5 (not present): index %d (expected: -1)\n", result4)
 
 	// Test case 5: Single element slice
 	singleArr := []int{42}
-	result5 := binarySearch(singleArr, 42)
+	result5 := BinarySearch(singleArr, 42)
 	fmt.Printf("  Search in single element [42]: index %d (expected: 0)\n", result5)
 
 	// Test case 6: Single element not found
-	result6 := binarySearch(singleArr, 99)
+	result6 := BinarySearch(singleArr, 99)
 	fmt.Printf("  Search for 99 in [42]: index %d (expected: -1)\n", result6)
 
 	// Test case 7: Empty slice
 	emptyArr := []int{}
-	result7 := binarySearch(emptyArr, 5)
+	result7 := BinarySearch(emptyArr, 5)
 	fmt.Printf("  Search in empty slice: index %d (expected: -1)\n", result7)
 
-	// Test case 8: Duplicate values (finds first occurrence in sorted array)
+	// Test case 8: Duplicate values (BinarySearch finds an occurrence; the bounds find the run)
 	dupArr := []int{1, 2, 2, 2, 3, 4, 5}
-	result8 := binarySearch(dupArr, 2)
+	result8 := BinarySearch(dupArr, 2)
 	fmt.Printf("  Search for 2 in %v: index %d (finds an occurrence)\n", dupArr, result8)
+	fmt.Printf("  LowerBound/UpperBound of 2: %d/%d (expected: 1/3)\n",
+		LowerBound(dupArr, 2), UpperBound(dupArr, 2))
+
+	// Test case 9: Bounds of absent values are insertion points
+	fmt.Printf("  LowerBound/UpperBound of 0, 7 and 99 in %v: %d/%d, %d/%d, %d/%d (expected: 0/0, 3/3, 7/7)\n",
+		arr1, LowerBound(arr1, 0), UpperBound(arr1, 0), LowerBound(arr1, 7), UpperBound(arr1, 7),
+		LowerBound(arr1, 99), UpperBound(arr1, 99))
+	fmt.Printf("  LowerBound/UpperBound in empty slice: %d/%d (expected: 0/0)\n",
+		LowerBound(emptyArr, 5), UpperBound(emptyArr, 5))
+	fmt.Printf("  LowerBound/UpperBound of 42 in [42]: %d/%d (expected: 0/0)\n",
+		LowerBound(singleArr, 42), UpperBound(singleArr, 42))
+
+	// Test case 10: Generic over strings
+	words := []string{"ant", "bee", "bee", "cat", "dog"}
+	fmt.Printf("  Search for \"cat\" in %v: index %d (expected: 3), bee run %d..%d\n",
+		words, BinarySearch(words, "cat"), LowerBound(words, "bee"), UpperBound(words, "bee"))
+}
+
+// h3 -- Sorted Maintenance Demo Function
+// h4 -- Builds a sorted slice through insertSorted and shrinks it with removeSorted
+func sortedMaintenanceDemo() {
+	less := func(a, b int) bool { return a < b }
+
+	var arr []int
+	for _, v := range []int{7, 3, 9, 1, 3, 8} {
+		arr = insertSorted(arr, v, less)
+		fmt.Printf("  Insert %d: %v\n", v, arr)
+	}
+
+	for _, v := range []int{3, 9, 4} {
+		var removed bool
+		arr, removed = removeSorted(arr, v, less)
+		fmt.Printf("  Remove %d: %v (removed: %t)\n", v, arr, removed)
+	}
 }
 
 func main() {
@@ -148,7 +606,7 @@ func main() {
 	fmt.Printf("Array: %v\n", arr)
 	fmt.Printf("Target: %d\n", target)
 
-	index := binarySearch(arr, target)
+	index := BinarySearch(arr, target)
 	if index != -1 {
 		fmt.Printf("Result: Found %d at index %d\n", target, index)
 	} else {
@@ -161,24 +619,118 @@ func main() {
 	fmt.Println("===================")
 	validationTests()
 
-	// h3 -- Performance Tests
-	// h4 -- Measure performance with different slice sizes
-	fmt.Println("\n\n3. PERFORMANCE TESTS")
-	fmt.Println("===================")
-	fmt.Println("Note: Testing 10,000 iterations per case")
-	fmt.Println("      Slice contains even numbers [0, 2, 4, ...]")
+	// h3 -- Sorted Slice Maintenance
+	// h4 -- Keeps a slice sorted across inserts and removals
+	fmt.Println("\n3. SORTED SLICE MAINTENANCE")
+	fmt.Println("===========================")
+	sortedMaintenanceDemo()
+
+	// h3 -- Continuous Range Search
+	// h4 -- Binary search over real numbers with a fixed iteration budget
+	fmt.Println("\n4. CONTINUOUS RANGE SEARCH")
+	fmt.Println("==========================")
+	sqrt2 := searchFloat(0, 2, func(x float64) bool { return x*x >= 2 }, 100)
+	fmt.Printf("  √2 ≈ %.12f (error: %.1e)\n", sqrt2, math.Abs(sqrt2-math.Sqrt2))
+	cost := func(x float64) float64 { return x*x*x + x } // Monotonic increasing
+	crossing := searchFloat(0, 10, func(x float64) bool { return cost(x) >= 10 }, 60)
+	fmt.Printf("  x³ + x reaches 10 at x ≈ %.9f (cost: %.9f)\n", crossing, cost(crossing))
+
+	// h3 -- Differential Testing
+	// h4 -- Cross-checks search variants on random sorted slices with duplicates
+	fmt.Println("\n5. DIFFERENTIAL TESTING")
+	fmt.Println("=======================")
+	fmt.Printf("  BinarySearch vs lowerBoundSearch: %v\n",
+		crossCheck(BinarySearch[int], lowerBoundSearch, 200, 100, 1))
+	fmt.Printf("  BinarySearch vs JumpSearch: %v\n",
+		crossCheck(BinarySearch[int], JumpSearch, 200, 100, 1))
+	fmt.Printf("  BinarySearch vs InterpolationSearch: %v\n",
+		crossCheck(BinarySearch[int], InterpolationSearch, 200, 100, 1))
+	fmt.Printf("  BinarySearch vs brokenBinarySearch: %v\n",
+		crossCheck(BinarySearch[int], brokenBinarySearch, 200, 100, 1))
+
+	// h3 -- Bitonic Arrays
+	// h4 -- Peak finding and search on increasing-then-decreasing input
+	fmt.Println("\n6. BITONIC ARRAYS")
+	fmt.Println("=================")
+	bitonic := [][]int{
+		{1, 3, 8, 12, 4, 2}, // Peak in the middle
+		{10, 7, 5, 2},       // Peak at the start
+		{1, 4, 6, 9},        // Peak at the end
+		{5},                 // Single element
+	}
+	for _, b := range bitonic {
+		fmt.Printf("  %v: peak index %d, search 4 -> %d, search 2 -> %d\n",
+			b, findPeak(b), searchBitonic(b, 4), searchBitonic(b, 2))
+	}
+
+	// h3 -- Rotated Arrays
+	// h4 -- Minimum of rotated sorted slices with duplicate values
+	fmt.Println("\n7. ROTATED ARRAYS WITH DUPLICATES")
+	fmt.Println("=================================")
+	rotated := [][]int{
+		{2, 2, 2, 0, 1},
+		{1, 1, 1, 1},
+		{3, 3, 1, 3},
+		{1, 3, 3},
+		{10, 1, 10, 10, 10},
+		{4, 5, 6, 7, 0, 1, 2},
+	}
+	for _, r := range rotated {
+		fmt.Printf("  Minimum of %v: %d\n", r, findMinWithDuplicates(r))
+	}
+
+	// h3 -- Binary Search on the Answer
+	// h4 -- Book allocation checked against a DP reference
+	fmt.Println("\n8. BINARY SEARCH ON THE ANSWER")
+	fmt.Println("==============================")
+	pages := []int{12, 34, 67, 90}
+	for _, k := range []int{1, 2, 3, len(pages)} {
+		fmt.Printf("  %v into %d groups: %d (DP: %d)\n",
+			pages, k, minMaxPartition(pages, k), dpMinMaxPartition(pages, k))
+	}
+	partitionRng := rand.New(rand.NewSource(4))
+	mismatches := 0
+	const partitionTrials = 300
+	for t := 0; t < partitionTrials; t++ {
+		values := make([]int, 1+partitionRng.Intn(10))
+		for i := range values {
+			values[i] = partitionRng.Intn(50)
+		}
+		k := 1 + partitionRng.Intn(len(values)+1) // Occasionally more groups than elements
+		if minMaxPartition(values, k) != dpMinMaxPartition(values, k) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against DP: %d\n", partitionTrials, mismatches)
+
+	matrix := [][]int{
+		{1, 5, 9, 10},
+		{10, 11, 13, 14},
+		{12, 13, 15, 20},
+	}
+	fmt.Printf("  Sorted matrix %v:\n   ", matrix)
+	for _, k := range []int{1, 4, 5, 7, 12} {
+		fmt.Printf(" k=%d -> %d", k, kthSmallestInMatrix(matrix, k))
+	}
+	fmt.Print("\n  Staircase search:")
+	for _, target := range []int{10, 1, 20, 13, 7, 21} {
+		row, col := searchMatrixII(matrix, target)
+		fmt.Printf(" %d -> (%d,%d)", target, row, col)
+	}
 	fmt.Println()
 
-	// Test with different slice sizes
-	performanceTest(1000)    // 1K elements
-	performanceTest(10000)   // 10K elements
-	performanceTest(100000)  // 100K elements
-	performanceTest(1000000) // 1M elements
+	// h3 -- Performance Tests
+	// h4 -- Timing lives in bs_test.go as testing.B benchmarks
+	fmt.Println("\n\n9. PERFORMANCE TESTS")
+	fmt.Println("===================")
+	fmt.Println("Binary, jump and interpolation search each have a benchmark covering")
+	fmt.Println("their own best/average/worst/not-found cases for 1K-1M elements:")
+	fmt.Println("  go test -bench='(Binary|Jump|Interpolation)Search' bs.go bs_test.go")
 
 	// h3 -- Algorithm Analysis
 	// h4 -- Educational summary of binary search characteristics
-	fmt.Println("\n\n4. ALGORITHM ANALYSIS")
-	fmt.Println("====================")
+	fmt.Println("\n\n10. ALGORITHM ANALYSIS")
+	fmt.Println("=====================")
 	fmt.Println("Time Complexity: O(log n) - logarithmic time")
 	fmt.Println("  Each step halves the search space")
 	fmt.Println("  Extremely efficient for large datasets")
@@ -197,6 +749,8 @@ func main() {
 
 	fmt.Println("Comparison with Other Search Algorithms:")
 	fmt.Println("  vs Linear Search: O(log n) vs O(n) - exponential speedup")
+	fmt.Println("  vs Jump Search: O(log n) vs O(√n) - jump search never steps backwards")
+	fmt.Println("  vs Interpolation Search: O(log n) vs O(log log n) on uniform keys, O(n) when skewed")
 	fmt.Println("  vs Hash Tables: O(log n) vs O(1) but maintains order")
 	fmt.Println("  vs Binary Search Trees: same complexity but simpler")
 	fmt.Println()
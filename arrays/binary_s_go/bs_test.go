@@ -0,0 +1,282 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestBinarySearch(t *testing.T) {
+	evens := []int{2, 4, 6, 8, 10, 12, 14}
+	tests := []struct {
+		name   string
+		arr    []int
+		target int
+		want   int
+	}{
+		{"middle", evens, 10, 4},
+		{"first element", evens, 2, 0},
+		{"last element", evens, 14, 6},
+		{"not present", evens, 5, -1},
+		{"below range", evens, 0, -1},
+		{"above range", evens, 99, -1},
+		{"single element", []int{42}, 42, 0},
+		{"single element not found", []int{42}, 99, -1},
+		{"empty slice", []int{}, 5, -1},
+		{"nil slice", nil, 5, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BinarySearch(tt.arr, tt.target); got != tt.want {
+				t.Errorf("BinarySearch(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBinarySearchDuplicates(t *testing.T) {
+	arr := []int{1, 2, 2, 2, 3, 4, 5}
+	got := BinarySearch(arr, 2)
+	if got < 1 || got > 3 {
+		t.Errorf("BinarySearch(%v, 2) = %d, want an index in [1, 3]", arr, got)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	dups := []int{1, 2, 2, 2, 3, 4, 5}
+	evens := []int{2, 4, 6, 8, 10, 12, 14}
+	tests := []struct {
+		name         string
+		arr          []int
+		target       int
+		lower, upper int
+	}{
+		{"duplicate run", dups, 2, 1, 3},
+		{"unique value", dups, 4, 5, 5},
+		{"absent, inside", evens, 7, 3, 3},
+		{"absent, below", evens, 0, 0, 0},
+		{"absent, above", evens, 99, 7, 7},
+		{"all equal", []int{3, 3, 3}, 3, 0, 2},
+		{"single element", []int{42}, 42, 0, 0},
+		{"empty slice", []int{}, 5, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LowerBound(tt.arr, tt.target); got != tt.lower {
+				t.Errorf("LowerBound(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.lower)
+			}
+			if got := UpperBound(tt.arr, tt.target); got != tt.upper {
+				t.Errorf("UpperBound(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.upper)
+			}
+		})
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	if err := crossCheck(BinarySearch[int], lowerBoundSearch, 200, 100, 1); err != nil {
+		t.Errorf("BinarySearch disagrees with lowerBoundSearch: %v", err)
+	}
+	if err := crossCheck(BinarySearch[int], brokenBinarySearch, 200, 100, 1); err == nil {
+		t.Error("crossCheck did not catch brokenBinarySearch")
+	}
+}
+
+func TestJumpAndInterpolationSearch(t *testing.T) {
+	evens := []int{2, 4, 6, 8, 10, 12, 14}
+	tests := []struct {
+		name   string
+		arr    []int
+		target int
+		want   int
+	}{
+		{"middle", evens, 10, 4},
+		{"first element", evens, 2, 0},
+		{"last element", evens, 14, 6},
+		{"not present", evens, 5, -1},
+		{"below range", evens, 0, -1},
+		{"above range", evens, 99, -1},
+		{"single element", []int{42}, 42, 0},
+		{"single element not found", []int{42}, 7, -1},
+		{"empty slice", []int{}, 5, -1},
+		{"all equal, present", []int{3, 3, 3, 3}, 3, -2},
+		{"all equal, absent", []int{3, 3, 3, 3}, 4, -1},
+		{"skewed values", []int{1, 2, 3, 4, 1_000_000}, 4, 3},
+		{"equal as float64", []int{1 << 62, 1<<62 + 1}, 1<<62 + 1, 1},
+		{"equal as float64, first", []int{1 << 62, 1<<62 + 1, 1<<62 + 2}, 1 << 62, 0},
+		{"equal as float64, absent", []int{1 << 62, 1<<62 + 2}, 1<<62 + 1, -1},
+	}
+	searches := map[string]searchFunc{"JumpSearch": JumpSearch, "InterpolationSearch": InterpolationSearch}
+	for name, search := range searches {
+		for _, tt := range tests {
+			t.Run(name+"/"+tt.name, func(t *testing.T) {
+				got := search(tt.arr, tt.target)
+				if tt.want == -2 { // Any matching index is acceptable
+					if got < 0 || tt.arr[got] != tt.target {
+						t.Errorf("%s(%v, %d) = %d, want an index of %d", name, tt.arr, tt.target, got, tt.target)
+					}
+				} else if got != tt.want {
+					t.Errorf("%s(%v, %d) = %d, want %d", name, tt.arr, tt.target, got, tt.want)
+				}
+			})
+		}
+		if err := crossCheck(BinarySearch[int], search, 200, 100, 2); err != nil {
+			t.Errorf("%s disagrees with BinarySearch: %v", name, err)
+		}
+	}
+}
+
+// searchCase is one benchmark target; arr lets a case use its own input.
+type searchCase struct {
+	name   string
+	arr    []int
+	target int
+}
+
+// benchmarkSearch times search on every case produced for each slice size.
+// The slices hold even numbers, so odd targets are never found.
+func benchmarkSearch(b *testing.B, search searchFunc, cases func(arr []int) []searchCase) {
+	for _, size := range []int{1_000, 10_000, 100_000, 1_000_000} {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = i * 2
+		}
+		for _, c := range cases(arr) {
+			b.Run(fmt.Sprintf("size=%d/%s", size, c.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					search(c.arr, c.target)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkBinarySearch(b *testing.B) {
+	benchmarkSearch(b, BinarySearch[int], func(arr []int) []searchCase {
+		size := len(arr)
+		return []searchCase{
+			{"best", arr, arr[(size-1)/2]}, // Found on the first probe
+			{"average", arr, arr[size/3]},
+			{"worst", arr, arr[size-1]},
+			{"not found", arr, -1},
+		}
+	})
+}
+
+func BenchmarkJumpSearch(b *testing.B) {
+	benchmarkSearch(b, JumpSearch, func(arr []int) []searchCase {
+		size := len(arr)
+		return []searchCase{
+			{"best", arr, arr[0]}, // Inside the first block
+			{"average", arr, arr[size/2]},
+			{"worst", arr, arr[size-1]}, // Every jump, then a scan of the last block
+			{"not found", arr, arr[size-1] - 1},
+		}
+	})
+}
+
+func BenchmarkInterpolationSearch(b *testing.B) {
+	benchmarkSearch(b, InterpolationSearch, func(arr []int) []searchCase {
+		size := len(arr)
+		// One huge last value makes every probe land near the start, so the
+		// search creeps forward one element at a time
+		skewed := make([]int, size)
+		for i := range skewed {
+			skewed[i] = i
+		}
+		skewed[size-1] = math.MaxInt / 2
+		return []searchCase{
+			{"best", arr, arr[size/2]}, // Evenly spaced: the first estimate is exact
+			{"average", arr, arr[size/3]},
+			{"worst", skewed, size - 2},
+			{"not found", arr, arr[size/3] + 1},
+		}
+	})
+}
+
+// sortedMatrix builds a rows x cols matrix whose rows and columns ascend, by
+// adding non-negative steps along both axes.
+func sortedMatrix(rng *rand.Rand, rows, cols int) [][]int {
+	m := make([][]int, rows)
+	for r := range m {
+		m[r] = make([]int, cols)
+		for c := range m[r] {
+			switch {
+			case r == 0 && c == 0:
+				m[r][c] = rng.Intn(5)
+			case r == 0:
+				m[r][c] = m[r][c-1] + rng.Intn(3)
+			case c == 0:
+				m[r][c] = m[r-1][c] + rng.Intn(3)
+			default:
+				m[r][c] = max(m[r-1][c], m[r][c-1]) + rng.Intn(3)
+			}
+		}
+	}
+	return m
+}
+
+func TestKthSmallestInMatrix(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5) // Includes 1xN, Nx1 and non-square shapes
+		m := sortedMatrix(rng, rows, cols)
+		var flat []int
+		for _, row := range m {
+			flat = append(flat, row...)
+		}
+		slices.Sort(flat)
+		for k := 1; k <= len(flat); k++ {
+			if got := kthSmallestInMatrix(m, k); got != flat[k-1] {
+				t.Fatalf("kthSmallestInMatrix(%v, %d) = %d, want %d", m, k, got, flat[k-1])
+			}
+		}
+	}
+}
+
+func TestSearchMatrixII(t *testing.T) {
+	matrix := [][]int{
+		{1, 4, 7, 11, 15},
+		{2, 5, 8, 12, 19},
+		{3, 6, 9, 16, 22},
+		{10, 13, 14, 17, 24},
+		{18, 21, 23, 26, 30},
+	}
+	tests := []struct {
+		name     string
+		target   int
+		row, col int
+	}{
+		{"top-left corner", 1, 0, 0},
+		{"top-right corner", 15, 0, 4},
+		{"bottom-left corner", 18, 4, 0},
+		{"bottom-right corner", 30, 4, 4},
+		{"middle", 9, 2, 2},
+		{"absent, inside range", 20, -1, -1},
+		{"absent, below range", 0, -1, -1},
+		{"absent, above range", 31, -1, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if row, col := searchMatrixII(matrix, tt.target); row != tt.row || col != tt.col {
+				t.Errorf("searchMatrixII(%d) = (%d, %d), want (%d, %d)", tt.target, row, col, tt.row, tt.col)
+			}
+		})
+	}
+	if row, col := searchMatrixII(nil, 1); row != -1 || col != -1 {
+		t.Errorf("searchMatrixII on an empty matrix = (%d, %d), want (-1, -1)", row, col)
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 200; trial++ {
+		m := sortedMatrix(rng, 1+rng.Intn(5), 1+rng.Intn(5))
+		for target := -1; target <= m[len(m)-1][len(m[0])-1]+1; target++ {
+			present := slices.ContainsFunc(m, func(row []int) bool { return slices.Contains(row, target) })
+			row, col := searchMatrixII(m, target)
+			if found := row >= 0; found != present || (found && m[row][col] != target) {
+				t.Fatalf("searchMatrixII(%v, %d) = (%d, %d), present %t", m, target, row, col, present)
+			}
+		}
+	}
+}
@@ -0,0 +1,681 @@
+// h1 -- Grid Algorithms in Go
+// h2 -- Problems over 2D grids of cells, visited through row-major offsets
+// h2 -- Includes counting islands with 4- or 8-directional connectivity and flood fill
+// h2 -- Matrix rotations built from transposes and row reversals, and spiral traversal
+// h2 -- In-place matrix rewrites that reuse the matrix itself as scratch space
+// h2 -- Conway's Game of Life, with a copying and a bit-packed in-place step
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// h3 -- Row-Major Index Helper
+// h4 -- Same offset formula as the 2D row-major address calculation: i * COLS + j
+func rowMajor(i, j, cols int) int {
+	return i*cols + j
+}
+
+// h3 -- Rectangular Shape Check
+// h4 -- Panics with a descriptive message when the rows of grid differ in length
+// h6 -- Functions that derive cols from grid[0] call this first, so a jagged grid fails
+// h6 -- loudly instead of indexing out of range or silently skipping cells
+func mustBeRectangular[T any](fn string, grid [][]T) {
+	for i, row := range grid {
+		if len(row) != len(grid[0]) {
+			panic(fmt.Sprintf("%s: row %d has %d columns, want %d", fn, i, len(row), len(grid[0])))
+		}
+	}
+}
+
+// h3 -- Neighbour Directions
+// h4 -- Row and column deltas for edge-sharing (4-way) and edge-or-corner-sharing (8-way) cells
+var (
+	fourWay  = [][2]int{{-1, 0}, {0, 1}, {1, 0}, {0, -1}}
+	eightWay = [][2]int{{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1}}
+)
+
+// h3 -- Grid Breadth-First Fill
+// h4 -- Visits every cell reachable from (r, c) through neighbouring cells that satisfy inRegion
+// h5 -- rows, cols: Grid dimensions; cells outside them are never visited
+// h5 -- dirs: Neighbour deltas (fourWay or eightWay)
+// h5 -- seen: Row-major visited flags, shared across calls so each cell is filled at most once
+// h5 -- visit: Called once per filled cell, in BFS order
+// h6 -- Uses an explicit queue, so large regions cannot overflow the call stack
+// h6 -- Time Complexity: O(cells in the region * len(dirs))
+func bfsFill(rows, cols, r, c int, dirs [][2]int, seen []bool,
+	inRegion func(r, c int) bool, visit func(r, c int)) {
+	seen[rowMajor(r, c, cols)] = true
+	queue := [][2]int{{r, c}}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		visit(cell[0], cell[1])
+		for _, d := range dirs {
+			nr, nc := cell[0]+d[0], cell[1]+d[1]
+			if nr < 0 || nr >= rows || nc < 0 || nc >= cols {
+				continue
+			}
+			if i := rowMajor(nr, nc, cols); !seen[i] && inRegion(nr, nc) {
+				seen[i] = true
+				queue = append(queue, [2]int{nr, nc})
+			}
+		}
+	}
+}
+
+// h3 -- Count Islands Function
+// h4 -- Counts connected components of '1' cells under the given connectivity
+// h5 -- grid: Rectangular grid of '1' (land) and '0' (water); it is not modified
+// h5 -- diagonal: When true, land cells touching only at a corner are connected too
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols), Space Complexity: O(rows * cols) for visited flags
+func countIslands(grid [][]byte, diagonal bool) int {
+	if len(grid) == 0 {
+		return 0
+	}
+	mustBeRectangular("countIslands", grid)
+	rows, cols := len(grid), len(grid[0])
+	dirs := fourWay
+	if diagonal {
+		dirs = eightWay
+	}
+
+	seen := make([]bool, rows*cols)
+	isLand := func(r, c int) bool { return grid[r][c] == '1' }
+	islands := 0
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if isLand(r, c) && !seen[rowMajor(r, c, cols)] {
+				islands++ // Every unseen land cell starts a new island
+				bfsFill(rows, cols, r, c, dirs, seen, isLand, func(int, int) {})
+			}
+		}
+	}
+	return islands
+}
+
+// h3 -- Number of Islands Function
+// h4 -- Classic 4-directional island count; see countIslands for 8-directional
+func numIslands(grid [][]byte) int {
+	return countIslands(grid, false)
+}
+
+// h3 -- Flood Fill Function
+// h4 -- Paint bucket: recolors the 4-connected region sharing the colour of (sr, sc)
+// h5 -- image: Rectangular grid of colours, recoloured in place
+// h5 -- sr, sc: Start cell; an out-of-range start leaves the image unchanged
+// h6 -- Returns: image, for chaining
+// h6 -- Note: When the start already has newColor there is nothing to do, and
+// h6 --       returning early avoids refilling the region forever
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols)
+func floodFill(image [][]int, sr, sc, newColor int) [][]int {
+	mustBeRectangular("floodFill", image)
+	if sr < 0 || sr >= len(image) || sc < 0 || sc >= len(image[sr]) {
+		return image
+	}
+	oldColor := image[sr][sc]
+	if oldColor == newColor {
+		return image
+	}
+	rows, cols := len(image), len(image[0])
+	seen := make([]bool, rows*cols)
+	bfsFill(rows, cols, sr, sc, fourWay, seen,
+		func(r, c int) bool { return image[r][c] == oldColor },
+		func(r, c int) { image[r][c] = newColor })
+	return image
+}
+
+// h3 -- Recursive Flood Fill
+// h4 -- Reference implementation: textbook depth-first recursion on the same contract
+func recursiveFloodFill(image [][]int, r, c, oldColor, newColor int) {
+	if r < 0 || r >= len(image) || c < 0 || c >= len(image[r]) || image[r][c] != oldColor {
+		return
+	}
+	image[r][c] = newColor
+	for _, d := range fourWay {
+		recursiveFloodFill(image, r+d[0], c+d[1], oldColor, newColor)
+	}
+}
+
+// h3 -- Rotate Matrix Function
+// h4 -- Rotates a square matrix 90° clockwise in place
+// h6 -- Transposing swaps (i, j) with (j, i); reversing each row then sends column j to
+// h6 -- column n-1-j, which together map (i, j) to (j, n-1-i)
+// h6 -- Panics if the matrix is not square, since the shape would have to change
+// h6 -- Time Complexity: O(n²), Space Complexity: O(1)
+func rotateMatrix(matrix [][]int) {
+	n := len(matrix)
+	for i, row := range matrix {
+		if len(row) != n {
+			panic(fmt.Sprintf("rotateMatrix: row %d has %d columns, want %d", i, len(row), n))
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			matrix[i][j], matrix[j][i] = matrix[j][i], matrix[i][j]
+		}
+	}
+	for _, row := range matrix {
+		slices.Reverse(row)
+	}
+}
+
+// h3 -- Rotated Copy Function
+// h4 -- Rotates any rows x cols matrix 90° clockwise into a new cols x rows matrix
+// h6 -- Same transpose-then-reverse-rows technique, writing the transpose into fresh rows
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols)
+func rotatedCopy(matrix [][]int) [][]int {
+	if len(matrix) == 0 {
+		return nil
+	}
+	mustBeRectangular("rotatedCopy", matrix)
+	rows, cols := len(matrix), len(matrix[0])
+	rotated := make([][]int, cols)
+	for j := range rotated {
+		rotated[j] = make([]int, rows)
+		for i := 0; i < rows; i++ {
+			rotated[j][i] = matrix[i][j]
+		}
+		slices.Reverse(rotated[j])
+	}
+	return rotated
+}
+
+// h3 -- Index-Mapping Rotation
+// h4 -- Reference implementation: element (i, j) lands at (j, rows-1-i) of the result
+// h6 -- Panics if the rows differ in length
+func rotateByMapping(matrix [][]int) [][]int {
+	if len(matrix) == 0 {
+		return nil
+	}
+	mustBeRectangular("rotateByMapping", matrix)
+	rows, cols := len(matrix), len(matrix[0])
+	rotated := make([][]int, cols)
+	for j := range rotated {
+		rotated[j] = make([]int, rows)
+	}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			rotated[j][rows-1-i] = matrix[i][j]
+		}
+	}
+	return rotated
+}
+
+// h3 -- Spiral Order Function
+// h4 -- Lists elements clockwise from the top-left corner, spiralling inwards
+// h5 -- top, bottom, left, right: Inclusive bounds of the ring not yet visited
+// h6 -- Each pass walks the top row, right column, bottom row and left column, then
+// h6 -- shrinks all four bounds; the last two sides are skipped once the ring is a
+// h6 -- single row or column, so no element is listed twice
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols)
+func spiralOrder(matrix [][]int) []int {
+	if len(matrix) == 0 {
+		return nil
+	}
+	mustBeRectangular("spiralOrder", matrix)
+	top, bottom := 0, len(matrix)-1
+	left, right := 0, len(matrix[0])-1
+	order := make([]int, 0, len(matrix)*len(matrix[0]))
+	for top <= bottom && left <= right {
+		for c := left; c <= right; c++ {
+			order = append(order, matrix[top][c])
+		}
+		for r := top + 1; r <= bottom; r++ {
+			order = append(order, matrix[r][right])
+		}
+		if top < bottom && left < right {
+			for c := right - 1; c >= left; c-- {
+				order = append(order, matrix[bottom][c])
+			}
+			for r := bottom - 1; r > top; r-- {
+				order = append(order, matrix[r][left])
+			}
+		}
+		top, bottom, left, right = top+1, bottom-1, left+1, right-1
+	}
+	return order
+}
+
+// h3 -- Peeling Spiral Order
+// h4 -- Reference implementation: take the top row, turn the rest counter-clockwise, repeat
+// h6 -- A counter-clockwise turn is three clockwise rotatedCopy calls; O((rows * cols)²) overall
+func peelSpiral(matrix [][]int) []int {
+	var order []int
+	for len(matrix) > 0 && len(matrix[0]) > 0 {
+		order = append(order, matrix[0]...)
+		matrix = matrix[1:]
+		for k := 0; k < 3 && len(matrix) > 0; k++ {
+			matrix = rotatedCopy(matrix)
+		}
+	}
+	return order
+}
+
+// h3 -- Set Matrix Zeroes Function
+// h4 -- Zeroes the whole row and column of every zero in the original matrix
+// h5 -- Markers: matrix[i][0] == 0 flags row i, matrix[0][j] == 0 flags column j
+// h6 -- The first row and column hold everyone else's markers, so whether they
+// h6 -- themselves contained a zero is saved in two booleans first and applied last
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols), Space Complexity: O(1)
+func setZeroes(matrix [][]int) {
+	if len(matrix) == 0 {
+		return
+	}
+	mustBeRectangular("setZeroes", matrix)
+	rows, cols := len(matrix), len(matrix[0])
+	firstRowZero := slices.Contains(matrix[0], 0)
+	firstColZero := false
+	for r := 0; r < rows; r++ {
+		firstColZero = firstColZero || matrix[r][0] == 0
+	}
+
+	// Record markers for the interior, then clear interior cells from them
+	for r := 1; r < rows; r++ {
+		for c := 1; c < cols; c++ {
+			if matrix[r][c] == 0 {
+				matrix[r][0], matrix[0][c] = 0, 0
+			}
+		}
+	}
+	for r := 1; r < rows; r++ {
+		for c := 1; c < cols; c++ {
+			if matrix[r][0] == 0 || matrix[0][c] == 0 {
+				matrix[r][c] = 0
+			}
+		}
+	}
+
+	if firstRowZero {
+		clear(matrix[0])
+	}
+	if firstColZero {
+		for r := 0; r < rows; r++ {
+			matrix[r][0] = 0
+		}
+	}
+}
+
+// h3 -- Brute-Force Set Zeroes
+// h4 -- Reference implementation that records zero rows and columns in separate sets
+func bruteSetZeroes(matrix [][]int) [][]int {
+	zeroRows, zeroCols := map[int]bool{}, map[int]bool{}
+	for r, row := range matrix {
+		for c, v := range row {
+			if v == 0 {
+				zeroRows[r], zeroCols[c] = true, true
+			}
+		}
+	}
+	result := make([][]int, len(matrix))
+	for r, row := range matrix {
+		result[r] = slices.Clone(row)
+		for c := range row {
+			if zeroRows[r] || zeroCols[c] {
+				result[r][c] = 0
+			}
+		}
+	}
+	return result
+}
+
+// h3 -- Live Neighbour Counter
+// h4 -- Counts live cells among the 8 neighbours of (r, c); cells off the board are dead
+// h6 -- Only bit 0 is read, so the count is unaffected by next states packed into bit 1
+func liveNeighbours(board [][]int, r, c int) int {
+	live := 0
+	for _, d := range eightWay {
+		nr, nc := r+d[0], c+d[1]
+		if nr >= 0 && nr < len(board) && nc >= 0 && nc < len(board[nr]) {
+			live += board[nr][nc] & 1
+		}
+	}
+	return live
+}
+
+// h3 -- Life Rule
+// h4 -- A live cell survives with 2 or 3 live neighbours; a dead cell is born with exactly 3
+func nextCell(alive bool, neighbours int) bool {
+	return neighbours == 3 || (alive && neighbours == 2)
+}
+
+// h3 -- Game of Life Next State Function
+// h4 -- Computes the next generation into a new board, leaving board unchanged
+// h5 -- board: Rectangular grid of 0 (dead) and 1 (alive)
+// h6 -- Time Complexity: O(rows * cols), Space Complexity: O(rows * cols) for the result
+func gameOfLifeNextState(board [][]int) [][]int {
+	next := make([][]int, len(board))
+	for r, row := range board {
+		next[r] = make([]int, len(row))
+		for c, cell := range row {
+			if nextCell(cell == 1, liveNeighbours(board, r, c)) {
+				next[r][c] = 1
+			}
+		}
+	}
+	return next
+}
+
+// h3 -- In-Place Game of Life Function
+// h4 -- Advances board one generation without a second buffer
+// h5 -- Encoding: bit 0 holds the current state and bit 1 the next, so every neighbour
+// h5 --           count still sees the old generation; a final shift promotes bit 1
+// h6 -- Time Complexity: O(rows * cols), Space Complexity: O(1)
+func gameOfLifeInPlace(board [][]int) {
+	for r, row := range board {
+		for c, cell := range row {
+			if nextCell(cell&1 == 1, liveNeighbours(board, r, c)) {
+				board[r][c] |= 2
+			}
+		}
+	}
+	for _, row := range board {
+		for c := range row {
+			row[c] >>= 1
+		}
+	}
+}
+
+// h3 -- Counting Matrix Builder
+// h4 -- rows x cols matrix holding 1, 2, 3, ... in row-major order
+func countingMatrix(rows, cols int) [][]int {
+	matrix := make([][]int, rows)
+	for i := range matrix {
+		matrix[i] = make([]int, cols)
+		for j := range matrix[i] {
+			matrix[i][j] = rowMajor(i, j, cols) + 1
+		}
+	}
+	return matrix
+}
+
+// h3 -- Union-Find Island Counter
+// h4 -- Independent reference: starts with one set per land cell and merges adjacent pairs
+// h6 -- Only the forward half of the neighbours is checked, since adjacency is symmetric
+// h6 -- Panics if the rows differ in length
+func unionFindIslands(grid [][]byte, diagonal bool) int {
+	if len(grid) == 0 {
+		return 0
+	}
+	mustBeRectangular("unionFindIslands", grid)
+	rows, cols := len(grid), len(grid[0])
+	parent := make([]int, rows*cols)
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+		return i
+	}
+
+	sets := 0
+	for i := range parent {
+		parent[i] = i
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if grid[r][c] == '1' {
+				sets++
+			}
+		}
+	}
+
+	forward := [][2]int{{0, 1}, {1, 0}}
+	if diagonal {
+		forward = append(forward, [2]int{1, 1}, [2]int{1, -1})
+	}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if grid[r][c] != '1' {
+				continue
+			}
+			for _, d := range forward {
+				nr, nc := r+d[0], c+d[1]
+				if nr >= rows || nc < 0 || nc >= cols || grid[nr][nc] != '1' {
+					continue
+				}
+				a, b := find(rowMajor(r, c, cols)), find(rowMajor(nr, nc, cols))
+				if a != b {
+					parent[a] = b
+					sets--
+				}
+			}
+		}
+	}
+	return sets
+}
+
+// h3 -- Grid Parser
+// h4 -- Turns rows of '0'/'1' strings into the [][]byte grid numIslands expects
+func parseGrid(rows ...string) [][]byte {
+	grid := make([][]byte, len(rows))
+	for i, row := range rows {
+		grid[i] = []byte(row)
+	}
+	return grid
+}
+
+func main() {
+	fmt.Println("=== GRID ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Number of Islands
+	// h4 -- Edge cases under both connectivity modes, then random grids against union-find
+	fmt.Println("1. NUMBER OF ISLANDS")
+	fmt.Println("====================")
+
+	grids := []struct {
+		name        string
+		grid        [][]byte
+		four, eight int
+	}{
+		{"all water", parseGrid("000", "000"), 0, 0},
+		{"all land", parseGrid("111", "111", "111"), 1, 1},
+		{"diagonal only", parseGrid("100", "010", "001"), 3, 1},
+		{"checkerboard", parseGrid("1010", "0101", "1010"), 6, 1},
+		{"mixed", parseGrid("11000", "11000", "00100", "00011"), 3, 1},
+		{"empty", nil, 0, 0},
+	}
+	for _, g := range grids {
+		four, eight := numIslands(g.grid), countIslands(g.grid, true)
+		status := "✓"
+		if four != g.four || eight != g.eight {
+			status = "✗"
+		}
+		fmt.Printf("  %s %-13s 4-way: %d, 8-way: %d (expected %d, %d)\n",
+			status, g.name, four, eight, g.four, g.eight)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	mismatches := 0
+	const trials = 300
+	for t := 0; t < trials; t++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		grid := make([][]byte, rows)
+		for r := range grid {
+			grid[r] = make([]byte, cols)
+			for c := range grid[r] {
+				grid[r][c] = "01"[rng.Intn(2)]
+			}
+		}
+		for _, diagonal := range []bool{false, true} {
+			if countIslands(grid, diagonal) != unionFindIslands(grid, diagonal) {
+				mismatches++
+			}
+		}
+	}
+	fmt.Printf("  Random grids vs union-find: %d trials, mismatches: %d\n", trials, mismatches)
+	func() {
+		defer func() { fmt.Printf("  Jagged grid: %v\n", recover()) }()
+		numIslands(parseGrid("110", "1"))
+	}()
+
+	// h3 -- Flood Fill
+	// h4 -- Whole-image and walled-in regions, the no-op case, then random images vs recursion
+	fmt.Println("\n2. FLOOD FILL")
+	fmt.Println("=============")
+
+	fills := []struct {
+		name          string
+		image         [][]int
+		sr, sc, color int
+	}{
+		{"fully connected", [][]int{{1, 1, 1}, {1, 1, 1}}, 0, 0, 7},
+		{"bordered", [][]int{{2, 2, 2, 2}, {2, 0, 0, 2}, {2, 0, 0, 2}, {2, 2, 2, 0}}, 1, 1, 5},
+		{"diagonal stops", [][]int{{1, 1, 0}, {1, 0, 0}, {0, 0, 1}}, 0, 0, 3},
+		{"same colour", [][]int{{4, 4}, {0, 4}}, 0, 0, 4},
+	}
+	for _, f := range fills {
+		before := fmt.Sprint(f.image)
+		fmt.Printf("  %-15s fill (%d,%d) with %d: %s -> %v\n",
+			f.name, f.sr, f.sc, f.color, before, floodFill(f.image, f.sr, f.sc, f.color))
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		image := make([][]int, rows)
+		want := make([][]int, rows)
+		for r := range image {
+			image[r] = make([]int, cols)
+			for c := range image[r] {
+				image[r][c] = rng.Intn(3)
+			}
+			want[r] = slices.Clone(image[r])
+		}
+		sr, sc, color := rng.Intn(rows), rng.Intn(cols), rng.Intn(3)
+		if want[sr][sc] != color {
+			recursiveFloodFill(want, sr, sc, want[sr][sc], color)
+		}
+		floodFill(image, sr, sc, color)
+		if !slices.EqualFunc(image, want, slices.Equal) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random images vs recursive fill: %d trials, mismatches: %d\n", trials, mismatches)
+
+	// h3 -- Rotate Matrix
+	// h4 -- In-place square rotations and non-square copies, checked against index mapping
+	fmt.Println("\n3. ROTATE MATRIX 90° CLOCKWISE")
+	fmt.Println("==============================")
+
+	for _, n := range []int{1, 2, 3, 4, 5} { // Odd sizes keep a fixed centre cell
+		matrix := countingMatrix(n, n)
+		want := rotateByMapping(matrix)
+		rotateMatrix(matrix)
+		inPlace := slices.EqualFunc(matrix, want, slices.Equal)
+		if n == 3 || n == 4 {
+			fmt.Printf("  %dx%d in place: %v\n", n, n, matrix)
+		}
+		for k := 0; k < 3; k++ {
+			rotateMatrix(matrix)
+		}
+		fmt.Printf("  %dx%d matches mapping: %t, four rotations restore it: %t\n", n, n,
+			inPlace, slices.EqualFunc(matrix, countingMatrix(n, n), slices.Equal))
+	}
+	for _, shape := range [][2]int{{2, 3}, {3, 2}, {1, 4}} {
+		matrix := countingMatrix(shape[0], shape[1])
+		rotated := rotatedCopy(matrix)
+		fmt.Printf("  %dx%d copy: %v -> %v (matches mapping: %t)\n", shape[0], shape[1],
+			matrix, rotated, slices.EqualFunc(rotated, rotateByMapping(matrix), slices.Equal))
+	}
+
+	// h3 -- Spiral Order
+	// h4 -- Square, wide, tall and degenerate shapes against a row-peeling reference
+	fmt.Println("\n4. SPIRAL ORDER")
+	fmt.Println("===============")
+
+	for _, shape := range [][2]int{{3, 3}, {4, 4}, {3, 5}, {5, 3}, {1, 4}, {4, 1}, {1, 1}} {
+		matrix := countingMatrix(shape[0], shape[1])
+		order := spiralOrder(matrix)
+		fmt.Printf("  %dx%d: %v (matches peeling: %t)\n",
+			shape[0], shape[1], order, slices.Equal(order, peelSpiral(matrix)))
+	}
+
+	// h3 -- Set Matrix Zeroes
+	// h4 -- Zeros in the marker row/column and no zeros at all, then random matrices
+	fmt.Println("\n5. SET MATRIX ZEROES")
+	fmt.Println("====================")
+
+	zeroCases := []struct {
+		name   string
+		matrix [][]int
+	}{
+		{"interior zero", [][]int{{1, 1, 1}, {1, 0, 1}, {1, 1, 1}}},
+		{"zero in first row", [][]int{{1, 0, 3}, {4, 5, 6}, {7, 8, 9}}},
+		{"zero in first column", [][]int{{1, 2, 3}, {0, 5, 6}, {7, 8, 9}}},
+		{"zero at the corner", [][]int{{0, 2, 3}, {4, 5, 6}}},
+		{"no zeros", [][]int{{1, 2}, {3, 4}}},
+	}
+	for _, z := range zeroCases {
+		before := fmt.Sprint(z.matrix)
+		want := bruteSetZeroes(z.matrix)
+		setZeroes(z.matrix)
+		fmt.Printf("  %-20s %s -> %v (matches brute force: %t)\n",
+			z.name, before, z.matrix, slices.EqualFunc(z.matrix, want, slices.Equal))
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		matrix := make([][]int, 1+rng.Intn(6))
+		cols := 1 + rng.Intn(6)
+		for r := range matrix {
+			matrix[r] = make([]int, cols)
+			for c := range matrix[r] {
+				matrix[r][c] = rng.Intn(8) // About one cell in eight is zero
+			}
+		}
+		want := bruteSetZeroes(matrix)
+		setZeroes(matrix)
+		if !slices.EqualFunc(matrix, want, slices.Equal) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random matrices vs brute force: %d trials, mismatches: %d\n", trials, mismatches)
+
+	// h3 -- Game of Life
+	// h4 -- Still life and oscillator patterns, then the in-place step against the copying one
+	fmt.Println("\n6. GAME OF LIFE")
+	fmt.Println("===============")
+
+	block := [][]int{{0, 0, 0, 0}, {0, 1, 1, 0}, {0, 1, 1, 0}, {0, 0, 0, 0}}
+	fmt.Printf("  Block is a still life: %t\n",
+		slices.EqualFunc(gameOfLifeNextState(block), block, slices.Equal))
+
+	blinker := [][]int{{0, 0, 0}, {1, 1, 1}, {0, 0, 0}}
+	vertical := gameOfLifeNextState(blinker)
+	fmt.Printf("  Blinker: %v -> %v -> %v (period 2: %t)\n", blinker, vertical,
+		gameOfLifeNextState(vertical), slices.EqualFunc(gameOfLifeNextState(vertical), blinker, slices.Equal))
+
+	corner := [][]int{{1, 1}, {1, 0}} // Boundary cells: the dead corner has exactly 3 neighbours
+	fmt.Printf("  Corner: %v -> %v\n", corner, gameOfLifeNextState(corner))
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		board := make([][]int, 1+rng.Intn(8))
+		cols := 1 + rng.Intn(8)
+		for r := range board {
+			board[r] = make([]int, cols)
+			for c := range board[r] {
+				board[r][c] = rng.Intn(2)
+			}
+		}
+		for generation := 0; generation < 4; generation++ {
+			want := gameOfLifeNextState(board)
+			gameOfLifeInPlace(board)
+			if !slices.EqualFunc(board, want, slices.Equal) {
+				mismatches++
+				break
+			}
+		}
+	}
+	fmt.Printf("  In-place vs copying, 4 generations: %d trials, mismatches: %d\n", trials, mismatches)
+}
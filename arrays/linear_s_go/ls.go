@@ -1,12 +1,14 @@
 // h1 -- Linear Search Algorithm Implementation in Go
 // h2 -- Go implementation using slices and range loops
-// h2 -- Includes proper benchmarking and performance comparison
+// h2 -- Benchmarks and unit tests live in ls_test.go
 
 package main
 
 import (
 	"fmt"
 	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -26,41 +28,64 @@ func linearSearch(arr []int, target int) int {
 	return -1
 }
 
-// h3 -- Performance Test Function
-// h4 -- Tests search performance with large slices
-// h5 -- size: Size of test slice to generate
-// h6 -- Uses proper timing and ensures worst-case scenario
-func performanceTest(size int) {
-	// Create slice with specified capacity
-	largeArr := make([]int, size)
-
-	// Initialize with sequential values (not random for consistency)
-	for i := 0; i < size; i++ {
-		largeArr[i] = i
-	}
-
-	// Set target to last element for worst-case performance
-	target := size - 1
+// How many elements a worker scans between checks of the shared best index
+const cancelCheckInterval = 1024
 
-	// Warm up the function (run once to avoid cold start)
-	linearSearch(largeArr, target)
-
-	// Time multiple iterations for more accurate measurement
-	const iterations = 1000
-	start := time.Now()
+// h3 -- Atomic Minimum Helper
+// h4 -- Lowers *v to x unless another goroutine already stored something smaller
+func storeMin(v *atomic.Int64, x int64) {
+	for {
+		cur := v.Load()
+		if x >= cur || v.CompareAndSwap(cur, x) {
+			return
+		}
+	}
+}
 
-	for i := 0; i < iterations; i++ {
-		linearSearch(largeArr, target)
+// h3 -- Parallel Linear Search Function
+// h4 -- Splits arr into contiguous chunks and scans them in separate goroutines
+// h5 -- arr: Slice of integers to search through
+// h5 -- target: Value to search for
+// h5 -- workers: Number of goroutines; <= 1 falls back to linearSearch
+// h6 -- Returns: Smallest index holding target, or -1 if not found
+// h6 -- Time Complexity: O(n / workers) per goroutine when the target is absent
+// h6 -- Note: The lowest found index is kept in an atomic that doubles as the cancellation
+// h6 --       signal - a worker whose chunk starts after it cannot improve on it and stops.
+// h6 --       Earlier chunks keep scanning, since only they can hold a smaller index.
+func ParallelLinearSearch(arr []int, target int, workers int) int {
+	n := len(arr)
+	workers = min(workers, n) // Never spawn goroutines with empty chunks
+	if workers <= 1 {
+		return linearSearch(arr, target)
 	}
 
-	elapsed := time.Since(start)
-	averageTime := elapsed / time.Duration(iterations)
+	var best atomic.Int64
+	best.Store(int64(n)) // n means "not found yet"
+	chunk := (n + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		hi := min(lo+chunk, n)
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			for block := lo; block < hi; block += cancelCheckInterval {
+				if best.Load() < int64(lo) {
+					return // An earlier chunk already has a match
+				}
+				if i := linearSearch(arr[block:min(block+cancelCheckInterval, hi)], target); i != -1 {
+					storeMin(&best, int64(block+i))
+					return
+				}
+			}
+		}(lo, hi)
+	}
+	wg.Wait()
 
-	fmt.Printf("Performance Test (Size: %d):\n", size)
-	fmt.Printf("  Target: %d (worst case - last element)\n", target)
-	fmt.Printf("  Average execution time: %v\n", averageTime)
-	fmt.Printf("  Time per element: %v\n", averageTime/time.Duration(size))
-	fmt.Printf("  Total iterations: %d\n", iterations)
+	if found := best.Load(); found < int64(n) {
+		return int(found)
+	}
+	return -1
 }
 
 func main() {
@@ -103,17 +128,18 @@ func main() {
 	index = linearSearch(arr, 9)
 	fmt.Printf("Search for 9 (not present): index %d\n", index)
 
+	// Parallel search must agree, including the first of several matches
+	dups := []int{7, 1, 9, 1, 9, 1}
+	fmt.Printf("\nParallel search for 9 in %v with 3 workers: index %d (linear: %d)\n",
+		dups, ParallelLinearSearch(dups, 9, 3), linearSearch(dups, 9))
+	fmt.Printf("Parallel search for 4 in %v with 8 workers: index %d\n", arr, ParallelLinearSearch(arr, 4, 8))
+
 	// h3 -- Performance Tests
-	// h4 -- Measure performance with different slice sizes
+	// h4 -- Timing lives in ls_test.go as testing.B benchmarks
 	fmt.Println("\n\n2. PERFORMANCE TESTS")
 	fmt.Println("===================")
-	fmt.Println("Note: Testing worst-case scenario (target at end)")
-	fmt.Println("      Averaging over 1000 iterations for accuracy")
-
-	// Test with different slice sizes
-	performanceTest(1000)   // 1K elements
-	performanceTest(10000)  // 10K elements
-	performanceTest(100000) // 100K elements
+	fmt.Println("Benchmarks cover best/average/worst/not-found cases for 1K-100K elements:")
+	fmt.Println("  go test -bench=LinearSearch ls.go ls_test.go")
 
 	// h3 -- Performance Analysis
 	// h4 -- Analyze the performance characteristics
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestLinearSearch(t *testing.T) {
+	arr := []int{5, 3, 8, 4, 2}
+	tests := []struct {
+		name   string
+		arr    []int
+		target int
+		want   int
+	}{
+		{"middle", arr, 4, 3},
+		{"first element", arr, 5, 0},
+		{"last element", arr, 2, 4},
+		{"not present", arr, 9, -1},
+		{"duplicates return first", []int{1, 7, 7, 7}, 7, 1},
+		{"single element", []int{42}, 42, 0},
+		{"single element not found", []int{42}, 99, -1},
+		{"empty slice", []int{}, 5, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := linearSearch(tt.arr, tt.target); got != tt.want {
+				t.Errorf("linearSearch(%v, %d) = %d, want %d", tt.arr, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParallelLinearSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		arr     []int
+		target  int
+		workers int
+		want    int
+	}{
+		{"single worker falls back", []int{5, 3, 8}, 8, 1, 2},
+		{"zero workers falls back", []int{5, 3, 8}, 3, 0, 1},
+		{"negative workers falls back", []int{5, 3, 8}, 9, -2, -1},
+		{"first index across chunks", []int{0, 0, 7, 0, 7, 0, 7, 0}, 7, 4, 2},
+		{"match only in last chunk", []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, 9, 3, 8},
+		{"more workers than elements", []int{4, 2}, 2, 16, 1},
+		{"not found", []int{1, 2, 3, 4, 5, 6}, 10, 4, -1},
+		{"empty slice", []int{}, 1, 4, -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParallelLinearSearch(tt.arr, tt.target, tt.workers); got != tt.want {
+				t.Errorf("ParallelLinearSearch(%v, %d, %d) = %d, want %d",
+					tt.arr, tt.target, tt.workers, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParallelLinearSearchMatchesLinear(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		arr := make([]int, rng.Intn(5000))
+		for i := range arr {
+			arr[i] = rng.Intn(100) // Every value repeats, often in several chunks
+		}
+		target, workers := rng.Intn(110), 1+rng.Intn(12)
+		if got, want := ParallelLinearSearch(arr, target, workers), linearSearch(arr, target); got != want {
+			t.Fatalf("len %d, target %d, workers %d: got %d, want %d", len(arr), target, workers, got, want)
+		}
+	}
+}
+
+func BenchmarkLinearSearch(b *testing.B) {
+	for _, size := range []int{1_000, 10_000, 100_000} {
+		arr := make([]int, size)
+		for i := range arr {
+			arr[i] = i
+		}
+		cases := []struct {
+			name   string
+			target int
+		}{
+			{"best", 0},
+			{"average", size / 2},
+			{"worst", size - 1},
+			{"not found", -1},
+		}
+		for _, c := range cases {
+			b.Run(fmt.Sprintf("size=%d/%s", size, c.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					linearSearch(arr, c.target)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkParallelLinearSearch(b *testing.B) {
+	const size = 10_000_000
+	arr := make([]int, size)
+	for i := range arr {
+		arr[i] = i
+	}
+	for _, workers := range []int{1, 2, 4, 8} {
+		for _, c := range []struct {
+			name   string
+			target int
+		}{{"worst", size - 1}, {"not found", -1}} {
+			b.Run(fmt.Sprintf("workers=%d/%s", workers, c.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					ParallelLinearSearch(arr, c.target, workers)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,277 @@
+// h1 -- Prefix Sum Structures in Go
+// h2 -- Answers range-sum queries without rescanning the underlying data
+// h2 -- Includes a 2D binary indexed (Fenwick) tree validated against brute force
+// h2 -- Counts subarrays with a target sum by hashing running prefix sums
+// h2 -- Builds products of all other elements from prefix and suffix products
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// h3 -- Row-Major Index Helper
+// h4 -- Same offset formula as the 2D row-major address calculation: i * COLS + j
+func rowMajor(i, j, cols int) int {
+	return i*cols + j
+}
+
+// h3 -- 2D Binary Indexed Tree Type
+// h4 -- Each cell (i, j) stores the sum of a rectangle whose size follows the low bits of i and j
+// h5 -- tree: 1-based (rows+1) x (cols+1) grid flattened in row-major order
+type BIT2D struct {
+	rows, cols int
+	tree       []int
+}
+
+// h3 -- 2D BIT Constructor
+// h4 -- Creates an all-zero rows x cols grid
+func NewBIT2D(rows, cols int) *BIT2D {
+	return &BIT2D{rows: rows, cols: cols, tree: make([]int, (rows+1)*(cols+1))}
+}
+
+// h3 -- Update Function
+// h4 -- Adds delta to cell (r, c) (0-based)
+// h6 -- Time Complexity: O(log rows * log cols)
+func (b *BIT2D) Update(r, c, delta int) {
+	if r < 0 || r >= b.rows || c < 0 || c >= b.cols {
+		panic(fmt.Sprintf("BIT2D: cell (%d,%d) out of range for %dx%d grid", r, c, b.rows, b.cols))
+	}
+	for i := r + 1; i <= b.rows; i += i & -i {
+		for j := c + 1; j <= b.cols; j += j & -j {
+			b.tree[rowMajor(i, j, b.cols+1)] += delta
+		}
+	}
+}
+
+// h3 -- Prefix Sum Function
+// h4 -- Sums the rectangle from (0, 0) to (r, c) inclusive
+// h6 -- Returns: 0 when r or c is negative (empty rectangle)
+// h6 -- Time Complexity: O(log rows * log cols)
+func (b *BIT2D) PrefixSum(r, c int) int {
+	r, c = min(r, b.rows-1), min(c, b.cols-1)
+	sum := 0
+	for i := r + 1; i > 0; i -= i & -i {
+		for j := c + 1; j > 0; j -= j & -j {
+			sum += b.tree[rowMajor(i, j, b.cols+1)]
+		}
+	}
+	return sum
+}
+
+// h3 -- Range Sum Function
+// h4 -- Sums the rectangle (r1, c1)..(r2, c2) inclusive by inclusion-exclusion
+// h6 -- Time Complexity: Four prefix sums, O(log rows * log cols)
+func (b *BIT2D) RangeSum(r1, c1, r2, c2 int) int {
+	if r1 > r2 || c1 > c2 {
+		return 0
+	}
+	return b.PrefixSum(r2, c2) -
+		b.PrefixSum(r1-1, c2) -
+		b.PrefixSum(r2, c1-1) +
+		b.PrefixSum(r1-1, c1-1)
+}
+
+// h3 -- Brute-Force Range Sum Function
+// h4 -- Reference implementation that scans every cell in the rectangle
+func bruteRangeSum(grid [][]int, r1, c1, r2, c2 int) int {
+	sum := 0
+	for i := r1; i <= r2; i++ {
+		for j := c1; j <= c2; j++ {
+			sum += grid[i][j]
+		}
+	}
+	return sum
+}
+
+// h3 -- Subarray Sum Equals K Function
+// h4 -- Counts contiguous subarrays whose elements sum to exactly k
+// h5 -- seen: How many prefixes so far have each running sum
+// h6 -- A subarray (i, j] sums to k exactly when prefix[j] - prefix[i] == k,
+// h6 -- so each position adds the number of earlier prefixes equal to sum - k
+// h6 -- Works with negative numbers, where a sliding window cannot shrink safely
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func subarraySumEqualsK(arr []int, k int) int {
+	seen := map[int]int{0: 1} // The empty prefix lets subarrays start at index 0
+	count, sum := 0, 0
+	for _, v := range arr {
+		sum += v
+		count += seen[sum-k]
+		seen[sum]++
+	}
+	return count
+}
+
+// h3 -- Brute-Force Subarray Sum Counter
+// h4 -- Reference implementation that sums every (start, end) pair in O(n²)
+func bruteSubarraySumEqualsK(arr []int, k int) int {
+	count := 0
+	for i := range arr {
+		sum := 0
+		for j := i; j < len(arr); j++ {
+			sum += arr[j]
+			if sum == k {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// h3 -- Product of Array Except Self Function
+// h4 -- Each output is the product of every other element, computed without division
+// h5 -- First pass: result[i] holds the product of arr[0..i-1] (prefix product)
+// h5 -- Second pass: a running suffix product multiplies in arr[i+1..n-1]
+// h6 -- Zeros need no special handling since nothing is ever divided out
+// h6 -- Time Complexity: O(n), Space Complexity: O(1) beyond the output slice
+func productExceptSelf(arr []int) []int {
+	result := make([]int, len(arr))
+	prefix := 1
+	for i, v := range arr {
+		result[i] = prefix
+		prefix *= v
+	}
+	suffix := 1
+	for i := len(arr) - 1; i >= 0; i-- {
+		result[i] *= suffix
+		suffix *= arr[i]
+	}
+	return result
+}
+
+// h3 -- Brute-Force Product Except Self
+// h4 -- Reference implementation that multiplies every other element in O(n²)
+func bruteProductExceptSelf(arr []int) []int {
+	result := make([]int, len(arr))
+	for i := range arr {
+		result[i] = 1
+		for j, v := range arr {
+			if j != i {
+				result[i] *= v
+			}
+		}
+	}
+	return result
+}
+
+func main() {
+	fmt.Println("=== PREFIX SUM STRUCTURES - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- 2D Fenwick Tree Validation
+	// h4 -- Applies random updates and compares random rectangle queries with brute force
+	fmt.Println("1. 2D BINARY INDEXED TREE")
+	fmt.Println("=========================")
+
+	const ROWS, COLS = 8, 11
+	rng := rand.New(rand.NewSource(42))
+	bit := NewBIT2D(ROWS, COLS)
+	grid := make([][]int, ROWS)
+	for i := range grid {
+		grid[i] = make([]int, COLS)
+	}
+
+	for u := 0; u < 200; u++ {
+		r, c, delta := rng.Intn(ROWS), rng.Intn(COLS), rng.Intn(21)-10
+		bit.Update(r, c, delta)
+		grid[r][c] += delta
+	}
+
+	mismatches := 0
+	const queries = 500
+	for q := 0; q < queries; q++ {
+		r1, r2 := rng.Intn(ROWS), rng.Intn(ROWS)
+		c1, c2 := rng.Intn(COLS), rng.Intn(COLS)
+		r1, r2 = min(r1, r2), max(r1, r2)
+		c1, c2 = min(c1, c2), max(c1, c2)
+		if bit.RangeSum(r1, c1, r2, c2) != bruteRangeSum(grid, r1, c1, r2, c2) {
+			mismatches++
+		}
+	}
+	fmt.Printf("Grid: %dx%d, 200 random updates\n", ROWS, COLS)
+	fmt.Printf("Whole-grid sum: %d (brute force: %d)\n",
+		bit.PrefixSum(ROWS-1, COLS-1), bruteRangeSum(grid, 0, 0, ROWS-1, COLS-1))
+	fmt.Printf("Random rectangle queries: %d, mismatches: %d\n", queries, mismatches)
+
+	fmt.Println()
+
+	// h3 -- Subarray Sum Equals K
+	// h4 -- Hand-picked edge cases, then random arrays with negatives against brute force
+	fmt.Println("2. SUBARRAY SUM EQUALS K")
+	fmt.Println("========================")
+
+	cases := []struct {
+		arr  []int
+		k    int
+		want int
+	}{
+		{[]int{1, 1, 1}, 2, 2},                 // Overlapping windows [1 1] and [1 1]
+		{[]int{1, 2, 3}, 3, 2},                 // [1 2] and [3]
+		{[]int{0, 0, 0}, 0, 6},                 // Every one of the 6 subarrays sums to 0
+		{[]int{1, -1, 1, -1}, 0, 4},            // Negatives cancel out in overlapping spans
+		{[]int{3, 4, 7, -2, 2, 1, 4, 2}, 7, 6}, // Several overlapping spans sum to 7
+		{[]int{-1, -1, 1}, -2, 1},              // Negative target
+		{[]int{}, 0, 0},                        // Empty input has no subarrays
+	}
+	for _, c := range cases {
+		got := subarraySumEqualsK(c.arr, c.k)
+		status := "✓"
+		if got != c.want {
+			status = "✗"
+		}
+		fmt.Printf("  %s arr=%v, k=%d: %d subarrays (expected %d)\n", status, c.arr, c.k, got, c.want)
+	}
+
+	mismatches = 0
+	const trials = 500
+	for t := 0; t < trials; t++ {
+		arr := make([]int, rng.Intn(30))
+		for i := range arr {
+			arr[i] = rng.Intn(11) - 5 // Negatives and zeros in every array
+		}
+		k := rng.Intn(11) - 5
+		if subarraySumEqualsK(arr, k) != bruteSubarraySumEqualsK(arr, k) {
+			mismatches++
+		}
+	}
+	fmt.Printf("Random arrays vs brute force: %d trials, mismatches: %d\n", trials, mismatches)
+
+	fmt.Println()
+
+	// h3 -- Product of Array Except Self
+	// h4 -- Zeros and negatives, then random arrays against brute force
+	fmt.Println("3. PRODUCT OF ARRAY EXCEPT SELF")
+	fmt.Println("===============================")
+
+	productCases := []struct {
+		arr, want []int
+	}{
+		{[]int{1, 2, 3, 4}, []int{24, 12, 8, 6}},
+		{[]int{2, 0, 3, 4}, []int{0, 24, 0, 0}},      // One zero: only its slot is non-zero
+		{[]int{0, 5, 0, 2}, []int{0, 0, 0, 0}},       // Two zeros: every product is zero
+		{[]int{-1, 2, -3, 4}, []int{-24, 12, -8, 6}}, // Signs flip with each negative excluded
+		{[]int{7}, []int{1}},                         // Empty product for a single element
+	}
+	for _, c := range productCases {
+		got := productExceptSelf(c.arr)
+		status := "✓"
+		if !slices.Equal(got, c.want) {
+			status = "✗"
+		}
+		fmt.Printf("  %s %v -> %v (expected %v)\n", status, c.arr, got, c.want)
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		arr := make([]int, rng.Intn(12))
+		for i := range arr {
+			arr[i] = rng.Intn(7) - 3 // Zeros and negatives are common
+		}
+		if !slices.Equal(productExceptSelf(arr), bruteProductExceptSelf(arr)) {
+			mismatches++
+		}
+	}
+	fmt.Printf("Random arrays vs brute force: %d trials, mismatches: %d\n", trials, mismatches)
+}
@@ -0,0 +1,154 @@
+// h1 -- Array Rotation Algorithms Implementation in Go
+// h2 -- Detects cyclic shifts and reduces them to a canonical representative
+// h2 -- Uses linear-time string matching on slices of any comparable type
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// h3 -- Prefix Function (KMP Failure Table)
+// h4 -- fail[i] is the length of the longest proper prefix of pattern[:i+1] that is also its suffix
+// h6 -- Time Complexity: O(m)
+func prefixFunction[T comparable](pattern []T) []int {
+	fail := make([]int, len(pattern))
+	for i, k := 1, 0; i < len(pattern); i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = fail[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		fail[i] = k
+	}
+	return fail
+}
+
+// h3 -- Is Rotation Function
+// h4 -- b is a rotation of a exactly when b occurs inside a+a (and lengths match)
+// h5 -- a, b: Slices to compare
+// h6 -- Returns: true if b equals a cyclically shifted; two empty slices count as rotations
+// h6 -- Time Complexity: O(n) via KMP, Space Complexity: O(n)
+func isRotation[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+
+	// Scan a+a without materializing it by indexing modulo n
+	n := len(a)
+	fail := prefixFunction(b)
+	matched := 0
+	for i := 0; i < 2*n-1; i++ {
+		for matched > 0 && a[i%n] != b[matched] {
+			matched = fail[matched-1]
+		}
+		if a[i%n] == b[matched] {
+			matched++
+		}
+		if matched == n {
+			return true
+		}
+	}
+	return false
+}
+
+// h3 -- Least Rotation Function (Booth's Algorithm)
+// h4 -- Finds the start of the lexicographically smallest rotation
+// h5 -- arr: Slice to analyse
+// h6 -- Returns: Rotation offset k (0 for an empty slice)
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+// h6 -- Note: A KMP failure table over arr+arr, discarding candidates k as soon as a
+// h6 --       smaller character proves another start wins
+func leastRotation[T cmp.Ordered](arr []T) int {
+	n := len(arr)
+	if n == 0 {
+		return 0
+	}
+	fail := make([]int, 2*n)
+	for i := range fail {
+		fail[i] = -1
+	}
+
+	k := 0
+	for j := 1; j < 2*n; j++ {
+		c := arr[j%n]
+		i := fail[j-k-1]
+		for i != -1 && c != arr[(k+i+1)%n] {
+			if c < arr[(k+i+1)%n] {
+				k = j - i - 1
+			}
+			i = fail[i]
+		}
+		if i == -1 && c != arr[k%n] {
+			if c < arr[k%n] {
+				k = j
+			}
+			fail[j-k] = -1
+		} else {
+			fail[j-k] = i + 1
+		}
+	}
+	return k % n
+}
+
+// h3 -- Canonical Rotation Function
+// h4 -- Returns the lexicographically smallest rotation as a new slice
+// h6 -- Two slices are rotations of each other iff their canonical rotations are equal
+func canonicalRotation[T cmp.Ordered](arr []T) []T {
+	k := leastRotation(arr)
+	return append(slices.Clone(arr[k:]), arr[:k]...)
+}
+
+// h3 -- Brute-Force Canonical Rotation
+// h4 -- Reference that compares every rotation directly
+// h6 -- Time Complexity: O(n²)
+func bruteCanonicalRotation[T cmp.Ordered](arr []T) []T {
+	best := slices.Clone(arr)
+	for k := 1; k < len(arr); k++ {
+		candidate := append(slices.Clone(arr[k:]), arr[:k]...)
+		if slices.Compare(candidate, best) < 0 {
+			best = candidate
+		}
+	}
+	return best
+}
+
+func main() {
+	fmt.Println("=== ARRAY ROTATION ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Rotation Detection
+	// h4 -- True rotations, equal-length non-rotations and empty slices
+	fmt.Println("1. ROTATION DETECTION")
+	fmt.Println("=====================")
+	pairs := [][2][]int{
+		{{1, 2, 3}, {3, 1, 2}},
+		{{1, 2, 3}, {1, 3, 2}},
+		{{1, 1, 2, 1}, {1, 2, 1, 1}},
+		{{1, 1, 2, 1}, {1, 2, 2, 1}},
+		{{1, 2}, {1, 2, 1}},
+		{{}, {}},
+	}
+	for _, p := range pairs {
+		fmt.Printf("  %v vs %v: %t\n", p[0], p[1], isRotation(p[0], p[1]))
+	}
+
+	// h3 -- Canonical Rotation
+	// h4 -- Booth's algorithm versus trying every rotation
+	fmt.Println("\n2. CANONICAL ROTATION (BOOTH)")
+	fmt.Println("=============================")
+	for _, word := range []string{"bca", "cabbage", "abab", "aaaa", "baaab", "dcbadcba"} {
+		runes := []rune(word)
+		fmt.Printf("  %-9s -> %-9s (brute force: %s)\n",
+			word, string(canonicalRotation(runes)), string(bruteCanonicalRotation(runes)))
+	}
+	a, b := []int{3, 1, 2, 3, 1}, []int{1, 2, 3, 1, 3}
+	fmt.Printf("  %v and %v share canonical form: %t\n",
+		a, b, slices.Equal(canonicalRotation(a), canonicalRotation(b)))
+}
@@ -0,0 +1,171 @@
+// h1 -- Sliding Window Algorithms Implementation in Go
+// h2 -- Maintains state for a moving range of indices instead of rescanning it
+// h2 -- Each element enters and leaves the window once, giving linear time
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// h3 -- Nearby Duplicate Function
+// h4 -- Reports whether any value repeats at indices i < j with j - i <= k
+// h5 -- arr: Slice to scan
+// h5 -- k: Maximum index distance between the two occurrences
+// h6 -- Returns: true if such a pair exists
+// h6 -- Time Complexity: O(n), Space Complexity: O(min(n, k))
+// h6 -- Note: The set holds only the previous k elements, unlike full deduplication
+func containsNearbyDuplicate(arr []int, k int) bool {
+	if k <= 0 {
+		return false
+	}
+	window := make(map[int]struct{}, min(len(arr), k))
+
+	for i, v := range arr {
+		if _, seen := window[v]; seen {
+			return true
+		}
+		window[v] = struct{}{}
+
+		// Drop the element that is now more than k positions behind
+		if i >= k {
+			delete(window, arr[i-k])
+		}
+	}
+	return false
+}
+
+// h3 -- Monotonic Deque Type
+// h4 -- Deque whose elements are strictly ordered by before, front first
+// h5 -- before: Returns true when a should sit in front of b (e.g. a > b for a max-deque)
+// h6 -- The front is always the extreme of every element pushed and not yet evicted
+type MonotonicDeque[T any] struct {
+	items  []T
+	before func(a, b T) bool
+}
+
+// h3 -- Monotonic Deque Constructor
+func NewMonotonicDeque[T any](before func(a, b T) bool) *MonotonicDeque[T] {
+	return &MonotonicDeque[T]{before: before}
+}
+
+// h3 -- Push Function
+// h4 -- Drops back elements that v dominates, then appends v
+// h6 -- Time Complexity: O(1) amortized - each element is removed at most once
+// h6 -- Note: Dropped elements can never become the extreme while v is present
+func (d *MonotonicDeque[T]) Push(v T) {
+	for len(d.items) > 0 && !d.before(d.items[len(d.items)-1], v) {
+		d.items = d.items[:len(d.items)-1]
+	}
+	d.items = append(d.items, v)
+}
+
+// h3 -- Pop Expired Function
+// h4 -- Removes front elements that have left the window
+// h5 -- isExpired: Reports whether an element is outside the current window
+// h6 -- Time Complexity: O(1) amortized
+func (d *MonotonicDeque[T]) PopExpired(isExpired func(T) bool) {
+	for len(d.items) > 0 && isExpired(d.items[0]) {
+		d.items = d.items[1:]
+	}
+}
+
+// h3 -- Extreme Function
+// h6 -- Returns: Front element (the window's min or max)
+// h6 -- Panics: when the deque is empty
+func (d *MonotonicDeque[T]) Extreme() T {
+	if len(d.items) == 0 {
+		panic("MonotonicDeque: Extreme called on an empty deque")
+	}
+	return d.items[0]
+}
+
+// h3 -- Length Function
+func (d *MonotonicDeque[T]) Len() int { return len(d.items) }
+
+// h3 -- Sliding Window Extreme Function
+// h4 -- Reports the extreme of every length-k window using a deque of indices
+// h5 -- before: Ordering of values; a > b gives maxima, a < b gives minima
+// h6 -- Returns: len(arr)-k+1 values (nil when k is out of range)
+// h6 -- Time Complexity: O(n)
+func slidingWindowExtreme(arr []int, k int, before func(a, b int) bool) []int {
+	if k <= 0 || k > len(arr) {
+		return nil
+	}
+	deque := NewMonotonicDeque(func(i, j int) bool { return before(arr[i], arr[j]) })
+	result := make([]int, 0, len(arr)-k+1)
+
+	for i := range arr {
+		deque.Push(i)
+		deque.PopExpired(func(idx int) bool { return idx <= i-k })
+		if i >= k-1 {
+			result = append(result, arr[deque.Extreme()])
+		}
+	}
+	return result
+}
+
+// h3 -- Brute-Force Sliding Window Extreme
+// h4 -- Reference that rescans each window
+// h6 -- Time Complexity: O(n * k)
+func bruteWindowExtreme(arr []int, k int, before func(a, b int) bool) []int {
+	if k <= 0 || k > len(arr) {
+		return nil
+	}
+	result := make([]int, 0, len(arr)-k+1)
+	for start := 0; start+k <= len(arr); start++ {
+		best := arr[start]
+		for _, v := range arr[start+1 : start+k] {
+			if before(v, best) {
+				best = v
+			}
+		}
+		result = append(result, best)
+	}
+	return result
+}
+
+func main() {
+	fmt.Println("=== SLIDING WINDOW ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Nearby Duplicates
+	// h4 -- Probes the k boundary: duplicates exactly k apart and just beyond
+	fmt.Println("1. CONTAINS NEARBY DUPLICATE")
+	fmt.Println("============================")
+
+	cases := []struct {
+		arr      []int
+		k        int
+		expected bool
+	}{
+		{[]int{1, 2, 3, 1}, 3, true},        // Exactly k apart
+		{[]int{1, 2, 3, 1}, 2, false},       // One beyond k
+		{[]int{1, 0, 1, 1}, 1, true},        // Adjacent repeat
+		{[]int{1, 2, 3, 4, 5}, 10, false},   // No repeats at all
+		{[]int{5, 6, 7, 5, 6, 7}, 2, false}, // Every repeat is 3 apart
+		{[]int{}, 1, false},                 // Empty input
+	}
+	for _, c := range cases {
+		fmt.Printf("  %v, k=%d: %t (expected: %t)\n",
+			c.arr, c.k, containsNearbyDuplicate(c.arr, c.k), c.expected)
+	}
+
+	// h3 -- Monotonic Deque
+	// h4 -- Drives sliding-window maximum and minimum from the same structure
+	fmt.Println("\n2. MONOTONIC DEQUE WINDOWS")
+	fmt.Println("==========================")
+
+	greater := func(a, b int) bool { return a > b }
+	less := func(a, b int) bool { return a < b }
+	arr := []int{1, 3, -1, -3, 5, 3, 6, 7, 7, 2}
+	k := 3
+	maxima := slidingWindowExtreme(arr, k, greater)
+	minima := slidingWindowExtreme(arr, k, less)
+	fmt.Printf("  Array: %v, k = %d\n", arr, k)
+	fmt.Printf("  Window max: %v (matches brute force: %t)\n",
+		maxima, slices.Equal(maxima, bruteWindowExtreme(arr, k, greater)))
+	fmt.Printf("  Window min: %v (matches brute force: %t)\n",
+		minima, slices.Equal(minima, bruteWindowExtreme(arr, k, less)))
+}
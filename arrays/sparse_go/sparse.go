@@ -0,0 +1,202 @@
+// h1 -- Sparse Matrix Representations in Go
+// h2 -- Stores only non-zero entries instead of a full rows x cols block
+// h2 -- Coordinate (COO) format for building, compressed sparse row (CSR) for computing
+
+package sparse
+
+import (
+	"fmt"
+	"sort"
+)
+
+// h3 -- Entry Type
+// h4 -- One stored non-zero value and its coordinates
+type Entry struct {
+	Row, Col int
+	Value    float64
+}
+
+// h3 -- Sparse Matrix Type (COO)
+// h4 -- Unordered list of non-zero entries plus an index for O(1) lookups
+// h5 -- entries: Non-zero values in insertion order (reordered by deletes)
+// h5 -- index: Maps (row, col) to the entry's position in entries
+type SparseMatrix struct {
+	rows, cols int
+	entries    []Entry
+	index      map[[2]int]int
+}
+
+// h3 -- Sparse Matrix Constructor
+// h4 -- Creates an all-zero rows x cols matrix
+// h6 -- Panics: when either dimension is negative
+func NewSparseMatrix(rows, cols int) *SparseMatrix {
+	if rows < 0 || cols < 0 {
+		panic(fmt.Sprintf("sparse: invalid dimensions %dx%d", rows, cols))
+	}
+	return &SparseMatrix{rows: rows, cols: cols, index: make(map[[2]int]int)}
+}
+
+// h3 -- Dimension Accessors
+func (m *SparseMatrix) Rows() int { return m.rows }
+func (m *SparseMatrix) Cols() int { return m.cols }
+
+// h3 -- Non-Zero Count Function
+// h6 -- Returns: Number of stored entries
+func (m *SparseMatrix) NNZ() int { return len(m.entries) }
+
+// h3 -- Bounds Check Helper
+// h4 -- Panics with the offending coordinates instead of silently storing them
+func checkBounds(i, j, rows, cols int) {
+	if i < 0 || i >= rows || j < 0 || j >= cols {
+		panic(fmt.Sprintf("sparse: index (%d,%d) out of range for %dx%d matrix", i, j, rows, cols))
+	}
+}
+
+// h3 -- Get Function
+// h4 -- Returns the value at (i, j); absent entries are zero
+// h6 -- Time Complexity: O(1) average
+func (m *SparseMatrix) Get(i, j int) float64 {
+	checkBounds(i, j, m.rows, m.cols)
+	if pos, ok := m.index[[2]int{i, j}]; ok {
+		return m.entries[pos].Value
+	}
+	return 0
+}
+
+// h3 -- Set Function
+// h4 -- Stores v at (i, j); setting zero removes the entry to keep storage sparse
+// h6 -- Time Complexity: O(1) average
+func (m *SparseMatrix) Set(i, j int, v float64) {
+	checkBounds(i, j, m.rows, m.cols)
+	key := [2]int{i, j}
+	pos, ok := m.index[key]
+
+	switch {
+	case ok && v != 0:
+		m.entries[pos].Value = v
+	case ok:
+		// Swap-delete: move the last entry into the freed slot
+		last := len(m.entries) - 1
+		m.entries[pos] = m.entries[last]
+		m.index[[2]int{m.entries[pos].Row, m.entries[pos].Col}] = pos
+		m.entries = m.entries[:last]
+		delete(m.index, key)
+	case v != 0:
+		m.index[key] = len(m.entries)
+		m.entries = append(m.entries, Entry{Row: i, Col: j, Value: v})
+	}
+}
+
+// h3 -- Entries Function
+// h6 -- Returns: Copy of the stored entries sorted by row, then column
+func (m *SparseMatrix) Entries() []Entry {
+	sorted := append([]Entry(nil), m.entries...)
+	sort.Slice(sorted, func(a, b int) bool {
+		if sorted[a].Row != sorted[b].Row {
+			return sorted[a].Row < sorted[b].Row
+		}
+		return sorted[a].Col < sorted[b].Col
+	})
+	return sorted
+}
+
+// h3 -- CSR Matrix Type
+// h4 -- Row i's entries live in ColIdx/Values[RowPtr[i]:RowPtr[i+1]], sorted by column
+// h5 -- RowPtr: len Rows+1 offsets into ColIdx and Values
+type CSRMatrix struct {
+	Rows, Cols int
+	RowPtr     []int
+	ColIdx     []int
+	Values     []float64
+}
+
+// h3 -- COO To CSR Conversion
+// h4 -- Counts entries per row, prefix-sums the counts, then places sorted entries
+// h6 -- Time Complexity: O(nnz log nnz) for the sort, O(rows) for the offsets
+func (m *SparseMatrix) ToCSR() *CSRMatrix {
+	entries := m.Entries()
+	csr := &CSRMatrix{
+		Rows:   m.rows,
+		Cols:   m.cols,
+		RowPtr: make([]int, m.rows+1),
+		ColIdx: make([]int, len(entries)),
+		Values: make([]float64, len(entries)),
+	}
+
+	for _, e := range entries {
+		csr.RowPtr[e.Row+1]++
+	}
+	for i := 0; i < m.rows; i++ {
+		csr.RowPtr[i+1] += csr.RowPtr[i]
+	}
+	for k, e := range entries {
+		csr.ColIdx[k] = e.Col
+		csr.Values[k] = e.Value
+	}
+	return csr
+}
+
+// h3 -- CSR To COO Conversion
+// h4 -- Expands each row's slice of CSR arrays back into coordinate entries
+func (c *CSRMatrix) ToCOO() *SparseMatrix {
+	m := NewSparseMatrix(c.Rows, c.Cols)
+	for i := 0; i < c.Rows; i++ {
+		for k := c.RowPtr[i]; k < c.RowPtr[i+1]; k++ {
+			m.Set(i, c.ColIdx[k], c.Values[k])
+		}
+	}
+	return m
+}
+
+// h3 -- CSR Get Function
+// h4 -- Binary-searches the row's sorted column indices
+// h6 -- Time Complexity: O(log k) for k entries in row i
+func (c *CSRMatrix) Get(i, j int) float64 {
+	checkBounds(i, j, c.Rows, c.Cols)
+	lo, hi := c.RowPtr[i], c.RowPtr[i+1]
+	k := lo + sort.SearchInts(c.ColIdx[lo:hi], j)
+	if k < hi && c.ColIdx[k] == j {
+		return c.Values[k]
+	}
+	return 0
+}
+
+// h3 -- Sparse Matrix-Vector Multiply (SpMV)
+// h4 -- Computes y = A*x touching only the stored entries
+// h5 -- x: Vector of length Cols
+// h6 -- Returns: Vector of length Rows, or an error on a length mismatch
+// h6 -- Time Complexity: O(rows + nnz)
+func (c *CSRMatrix) MulVec(x []float64) ([]float64, error) {
+	if len(x) != c.Cols {
+		return nil, fmt.Errorf("sparse: vector length %d does not match %d columns", len(x), c.Cols)
+	}
+	y := make([]float64, c.Rows)
+	for i := 0; i < c.Rows; i++ {
+		sum := 0.0
+		for k := c.RowPtr[i]; k < c.RowPtr[i+1]; k++ {
+			sum += c.Values[k] * x[c.ColIdx[k]]
+		}
+		y[i] = sum
+	}
+	return y, nil
+}
+
+// h3 -- COO Matrix-Vector Multiply
+// h4 -- Converts to CSR and multiplies; convert once yourself for repeated products
+func (m *SparseMatrix) MulVec(x []float64) ([]float64, error) {
+	return m.ToCSR().MulVec(x)
+}
+
+// h3 -- Dense Conversion Function
+// h4 -- Materializes the full matrix in row-major [][]float64 form
+// h6 -- Useful as a reference when validating sparse operations on small inputs
+func (m *SparseMatrix) Dense() [][]float64 {
+	dense := make([][]float64, m.rows)
+	for i := range dense {
+		dense[i] = make([]float64, m.cols)
+	}
+	for _, e := range m.entries {
+		dense[e.Row][e.Col] = e.Value
+	}
+	return dense
+}
@@ -0,0 +1,111 @@
+package sparse
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// randomSparse fills roughly density of a rows x cols matrix with small
+// integer values, so products and sums are exact in float64.
+func randomSparse(rng *rand.Rand, rows, cols int, density float64) *SparseMatrix {
+	m := NewSparseMatrix(rows, cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if rng.Float64() < density {
+				m.Set(i, j, float64(rng.Intn(19)-9))
+			}
+		}
+	}
+	return m
+}
+
+func TestCSRRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 100; trial++ {
+		rows, cols := rng.Intn(8), rng.Intn(8) // Includes 0xN and Nx0 shapes
+		m := randomSparse(rng, rows, cols, 0.3)
+		back := m.ToCSR().ToCOO()
+		if back.Rows() != rows || back.Cols() != cols {
+			t.Fatalf("round trip changed shape %dx%d to %dx%d", rows, cols, back.Rows(), back.Cols())
+		}
+		if !slices.Equal(back.Entries(), m.Entries()) {
+			t.Fatalf("round trip changed entries: %v -> %v", m.Entries(), back.Entries())
+		}
+	}
+}
+
+func TestCSRGetMatchesCOO(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 50; trial++ {
+		m := randomSparse(rng, 1+rng.Intn(10), 1+rng.Intn(10), 0.25)
+		csr := m.ToCSR()
+		for i := 0; i < m.Rows(); i++ {
+			for j := 0; j < m.Cols(); j++ {
+				if got, want := csr.Get(i, j), m.Get(i, j); got != want {
+					t.Fatalf("CSR Get(%d, %d) = %g, want %g", i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSetZeroDeletes(t *testing.T) {
+	m := NewSparseMatrix(3, 3)
+	m.Set(0, 0, 1)
+	m.Set(1, 2, 2)
+	m.Set(2, 1, 3)
+	m.Set(0, 0, 0) // Deleting the first entry swaps the last one into its slot
+	m.Set(2, 2, 0) // Deleting an absent entry is a no-op
+
+	if m.NNZ() != 2 {
+		t.Errorf("NNZ = %d, want 2", m.NNZ())
+	}
+	want := []Entry{{1, 2, 2}, {2, 1, 3}}
+	if got := m.Entries(); !slices.Equal(got, want) {
+		t.Errorf("Entries = %v, want %v", got, want)
+	}
+	if m.Get(0, 0) != 0 || m.Get(2, 1) != 3 {
+		t.Errorf("Get after delete = %g, %g, want 0, 3", m.Get(0, 0), m.Get(2, 1))
+	}
+	m.Set(2, 1, 4) // The moved entry's index must still be correct
+	if m.Get(2, 1) != 4 || m.NNZ() != 2 {
+		t.Errorf("update after delete: Get = %g, NNZ = %d", m.Get(2, 1), m.NNZ())
+	}
+}
+
+func TestMulVecMatchesDense(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 100; trial++ {
+		rows, cols := 1+rng.Intn(10), 1+rng.Intn(10)
+		m := randomSparse(rng, rows, cols, 0.3)
+		x := make([]float64, cols)
+		for j := range x {
+			x[j] = float64(rng.Intn(11) - 5)
+		}
+
+		want := make([]float64, rows)
+		for i, row := range m.Dense() {
+			for j, v := range row {
+				want[i] += v * x[j]
+			}
+		}
+		got, err := m.MulVec(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, want) {
+			t.Fatalf("MulVec = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestMulVecLengthMismatch(t *testing.T) {
+	m := NewSparseMatrix(2, 3)
+	m.Set(0, 1, 5)
+	for _, n := range []int{0, 2, 4} {
+		if y, err := m.ToCSR().MulVec(make([]float64, n)); err == nil || y != nil {
+			t.Errorf("MulVec with length %d = (%v, %v), want an error", n, y, err)
+		}
+	}
+}
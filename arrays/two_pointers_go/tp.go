@@ -0,0 +1,351 @@
+// h1 -- Two Pointer Techniques Implementation in Go
+// h2 -- Solves array problems by moving two indices through the data
+// h2 -- Pointers may converge, chase each other, or advance at different speeds
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// h3 -- Find Duplicate Function (Floyd's Cycle Detection)
+// h4 -- Treats arr as a linked list where index i points to index arr[i]
+// h5 -- arr: n+1 integers, each in [1, n], so at least one value repeats
+// h6 -- Returns: The repeated value, or -1 if arr violates the constraints
+// h6 -- Time Complexity: O(n), Space Complexity: O(1); arr is not modified
+// h6 -- Note: Index 0 is never a target, so the walk from 0 leads into a cycle
+// h6 --       whose entrance has two incoming pointers - the duplicate value
+func findDuplicate(arr []int) int {
+	n := len(arr) - 1
+	if n < 1 {
+		return -1
+	}
+	for _, v := range arr {
+		if v < 1 || v > n {
+			return -1 // Constraint violated; the walk could leave the array
+		}
+	}
+
+	// Phase 1: tortoise moves one step, hare two, until they meet inside the cycle
+	slow, fast := arr[0], arr[arr[0]]
+	for slow != fast {
+		slow = arr[slow]
+		fast = arr[arr[fast]]
+	}
+
+	// Phase 2: restart one pointer; moving both one step lands on the cycle entrance
+	slow = 0
+	for slow != fast {
+		slow = arr[slow]
+		fast = arr[fast]
+	}
+	return slow
+}
+
+// h3 -- Container With Most Water Function
+// h4 -- Pointers start at both ends; the shorter line moves inward each step
+// h5 -- heights: Line heights at unit spacing
+// h6 -- Returns: Largest min(heights[i], heights[j]) * (j - i) over i < j (0 for fewer than two lines)
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+// h6 -- Note: Any container using the shorter line with a nearer partner is no taller
+// h6 --       and narrower, so that line can be discarded safely
+func maxArea(heights []int) int {
+	best := 0
+	left, right := 0, len(heights)-1
+	for left < right {
+		h := min(heights[left], heights[right])
+		best = max(best, h*(right-left))
+		if heights[left] < heights[right] {
+			left++
+		} else {
+			right--
+		}
+	}
+	return best
+}
+
+// h3 -- Brute-Force Max Area
+// h4 -- Reference that tries every pair of lines
+// h6 -- Time Complexity: O(n²)
+func bruteMaxArea(heights []int) int {
+	best := 0
+	for i := range heights {
+		for j := i + 1; j < len(heights); j++ {
+			best = max(best, min(heights[i], heights[j])*(j-i))
+		}
+	}
+	return best
+}
+
+// h3 -- Trapping Rain Water Function
+// h4 -- Converging pointers, each tracking the tallest wall seen from its side
+// h5 -- heights: Non-negative terrain heights at unit spacing
+// h6 -- Returns: Total units of water held after rain
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+// h6 -- Note: Water above a column is min(maxLeft, maxRight) - height; whichever side has
+// h6 --       the lower running max already knows that minimum, so it can be settled
+func trapRainWater(heights []int) int {
+	water := 0
+	left, right := 0, len(heights)-1
+	maxLeft, maxRight := 0, 0
+	for left < right {
+		if heights[left] < heights[right] {
+			maxLeft = max(maxLeft, heights[left])
+			water += maxLeft - heights[left]
+			left++
+		} else {
+			maxRight = max(maxRight, heights[right])
+			water += maxRight - heights[right]
+			right--
+		}
+	}
+	return water
+}
+
+// h3 -- Brute-Force Rain Water
+// h4 -- Reference that scans both directions from every column
+// h6 -- Time Complexity: O(n²)
+func bruteTrapRainWater(heights []int) int {
+	water := 0
+	for i, h := range heights {
+		maxLeft, maxRight := 0, 0
+		for _, l := range heights[:i+1] {
+			maxLeft = max(maxLeft, l)
+		}
+		for _, r := range heights[i:] {
+			maxRight = max(maxRight, r)
+		}
+		water += min(maxLeft, maxRight) - h
+	}
+	return water
+}
+
+// h3 -- Remove Element Function (Unordered)
+// h4 -- Fills each removed slot with the current last element and shrinks from the right
+// h5 -- arr: Slice compacted in place
+// h5 -- val: Value to remove
+// h6 -- Returns: New length k; arr[:k] holds the kept elements in unspecified order
+// h6 -- Time Complexity: O(n), Space Complexity: O(1); writes only once per removed element
+func removeElement(arr []int, val int) int {
+	i, n := 0, len(arr)
+	for i < n {
+		if arr[i] == val {
+			n--
+			arr[i] = arr[n] // Re-examine the moved element on the next pass
+		} else {
+			i++
+		}
+	}
+	return n
+}
+
+// h3 -- Remove Element Function (Stable)
+// h4 -- Slow pointer marks the write position, fast pointer scans
+// h6 -- Returns: New length k; arr[:k] keeps the original relative order
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+func removeElementStable(arr []int, val int) int {
+	k := 0
+	for _, v := range arr {
+		if v != val {
+			arr[k] = v
+			k++
+		}
+	}
+	return k
+}
+
+// h3 -- Sorted Intersection Function
+// h4 -- Advances whichever pointer sits on the smaller value; equal values are emitted once
+// h5 -- a, b: Slices sorted according to less (duplicates allowed)
+// h5 -- less: Strict ordering; x and y are equal when neither is less than the other
+// h6 -- Returns: Sorted values present in both inputs, each appearing once (set semantics)
+// h6 -- Time Complexity: O(n + m), Space Complexity: O(output)
+func intersectSorted[T any](a, b []T, less func(x, y T) bool) []T {
+	var out []T
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case less(a[i], b[j]):
+			i++
+		case less(b[j], a[i]):
+			j++
+		default:
+			out = appendUnique(out, a[i], less)
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+// h3 -- Sorted Union Function
+// h4 -- Standard merge that drops repeats of the last emitted value
+// h6 -- Returns: Sorted values present in either input, each appearing once
+// h6 -- Time Complexity: O(n + m), Space Complexity: O(output)
+func unionSorted[T any](a, b []T, less func(x, y T) bool) []T {
+	out := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if j == len(b) || (i < len(a) && !less(b[j], a[i])) {
+			out = appendUnique(out, a[i], less)
+			i++
+		} else {
+			out = appendUnique(out, b[j], less)
+			j++
+		}
+	}
+	return out
+}
+
+// h3 -- Append Unique Helper
+// h4 -- Appends v unless it equals the last element (out is sorted, so that is the only check)
+func appendUnique[T any](out []T, v T, less func(x, y T) bool) []T {
+	if n := len(out); n > 0 && !less(out[n-1], v) {
+		return out
+	}
+	return append(out, v)
+}
+
+func main() {
+	fmt.Println("=== TWO POINTER TECHNIQUES - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Find Duplicate
+	// h4 -- Duplicate at various positions and repeated more than twice
+	fmt.Println("1. FIND DUPLICATE (FLOYD)")
+	fmt.Println("=========================")
+
+	cases := []struct {
+		arr      []int
+		expected int
+	}{
+		{[]int{1, 3, 4, 2, 2}, 2}, // Duplicate at the end
+		{[]int{3, 1, 3, 4, 2}, 3}, // Duplicate at the start
+		{[]int{2, 2, 2, 2, 2}, 2}, // Same value repeated many times
+		{[]int{1, 4, 4, 2, 4}, 4}, // Repeated three times
+		{[]int{1, 1}, 1},          // Smallest valid input
+		{[]int{5, 1, 2}, -1},      // Value out of range
+	}
+	for _, c := range cases {
+		fmt.Printf("  %v: %d (expected: %d)\n", c.arr, findDuplicate(c.arr), c.expected)
+	}
+
+	// h3 -- Container With Most Water
+	// h4 -- Monotonic and symmetric profiles, then random checks against brute force
+	fmt.Println("\n2. CONTAINER WITH MOST WATER")
+	fmt.Println("============================")
+
+	areaCases := []struct {
+		name    string
+		heights []int
+	}{
+		{"classic", []int{1, 8, 6, 2, 5, 4, 8, 3, 7}},
+		{"increasing", []int{1, 2, 3, 4, 5, 6}},
+		{"decreasing", []int{6, 5, 4, 3, 2, 1}},
+		{"symmetric", []int{2, 5, 1, 5, 2}},
+		{"single line", []int{7}},
+	}
+	for _, c := range areaCases {
+		fmt.Printf("  %-12s %v: %d (brute force: %d)\n",
+			c.name, c.heights, maxArea(c.heights), bruteMaxArea(c.heights))
+	}
+
+	rng := rand.New(rand.NewSource(5))
+	mismatches := 0
+	const trials = 500
+	for t := 0; t < trials; t++ {
+		heights := make([]int, rng.Intn(20))
+		for i := range heights {
+			heights[i] = rng.Intn(15)
+		}
+		if maxArea(heights) != bruteMaxArea(heights) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against brute force: %d\n", trials, mismatches)
+
+	// h3 -- Trapping Rain Water
+	// h4 -- Flat and monotonic terrains hold nothing; random checks against brute force
+	fmt.Println("\n3. TRAPPING RAIN WATER")
+	fmt.Println("======================")
+
+	waterCases := []struct {
+		name    string
+		heights []int
+	}{
+		{"classic", []int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}},
+		{"basin", []int{4, 2, 0, 3, 2, 5}},
+		{"flat", []int{3, 3, 3, 3}},
+		{"increasing", []int{0, 1, 2, 3, 4}},
+		{"decreasing", []int{4, 3, 2, 1, 0}},
+		{"empty", []int{}},
+	}
+	for _, c := range waterCases {
+		fmt.Printf("  %-11s %v: %d (brute force: %d)\n",
+			c.name, c.heights, trapRainWater(c.heights), bruteTrapRainWater(c.heights))
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		heights := make([]int, rng.Intn(20))
+		for i := range heights {
+			heights[i] = rng.Intn(10)
+		}
+		if trapRainWater(heights) != bruteTrapRainWater(heights) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against brute force: %d\n", trials, mismatches)
+
+	// h3 -- Remove Element
+	// h4 -- Fast (unordered) and stable compaction, including all-match and no-match inputs
+	fmt.Println("\n4. REMOVE ELEMENT")
+	fmt.Println("=================")
+
+	removeCases := []struct {
+		arr []int
+		val int
+	}{
+		{[]int{3, 2, 2, 3}, 3},
+		{[]int{0, 1, 2, 2, 3, 0, 4, 2}, 2},
+		{[]int{7, 7, 7}, 7},
+		{[]int{1, 2, 3}, 9},
+		{[]int{}, 1},
+	}
+	for _, c := range removeCases {
+		fast := slices.Clone(c.arr)
+		k := removeElement(fast, c.val)
+		stable := slices.Clone(c.arr)
+		ks := removeElementStable(stable, c.val)
+		fmt.Printf("  %v remove %d: fast %v, stable %v (val absent: %t)\n",
+			c.arr, c.val, fast[:k], stable[:ks],
+			!slices.Contains(fast[:k], c.val) && !slices.Contains(stable[:ks], c.val))
+	}
+
+	// h3 -- Sorted Set Operations
+	// h4 -- Overlapping, disjoint, duplicate-heavy and one-empty inputs
+	fmt.Println("\n5. SORTED INTERSECTION AND UNION")
+	fmt.Println("================================")
+
+	intLess := func(x, y int) bool { return x < y }
+	setCases := []struct {
+		name string
+		a, b []int
+	}{
+		{"overlapping", []int{1, 3, 5, 7, 9}, []int{3, 4, 5, 6, 7}},
+		{"duplicates", []int{1, 2, 2, 2, 3}, []int{2, 2, 3, 3, 4}},
+		{"disjoint", []int{1, 2, 3}, []int{7, 8}},
+		{"one empty", []int{4, 4, 5}, nil},
+	}
+	for _, c := range setCases {
+		inter := intersectSorted(c.a, c.b, intLess)
+		union := unionSorted(c.a, c.b, intLess)
+		fmt.Printf("  %-11s %v, %v: ∩ %v, ∪ %v (sorted: %t)\n", c.name, c.a, c.b, inter, union,
+			slices.IsSorted(inter) && slices.IsSorted(union))
+	}
+	words := unionSorted([]string{"ant", "cat"}, []string{"bee", "cat", "dog"},
+		func(x, y string) bool { return x < y })
+	fmt.Printf("  strings: %v\n", words)
+}
@@ -0,0 +1,233 @@
+// h1 -- Backtracking Algorithms Implementation in Go
+// h2 -- Enumerates arrangements by extending partial solutions and undoing choices
+// h2 -- Includes an iterative lexicographic alternative for comparison
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// h3 -- Permutations Function
+// h4 -- Generates every ordering of arr by swapping each candidate into place
+// h5 -- arr: Elements to permute (left unmodified)
+// h6 -- Returns: All n! permutations (one empty permutation for empty input)
+// h6 -- Time Complexity: O(n * n!), Space Complexity: O(n * n!) for the output
+func permutations[T any](arr []T) [][]T {
+	work := slices.Clone(arr)
+	var result [][]T
+
+	var backtrack func(pos int)
+	backtrack = func(pos int) {
+		if pos == len(work) {
+			result = append(result, slices.Clone(work))
+			return
+		}
+		for i := pos; i < len(work); i++ {
+			work[pos], work[i] = work[i], work[pos] // Choose
+			backtrack(pos + 1)                      // Explore
+			work[pos], work[i] = work[i], work[pos] // Undo
+		}
+	}
+
+	backtrack(0)
+	return result
+}
+
+// h3 -- Next Permutation Function
+// h4 -- Rearranges arr into the next lexicographically greater permutation in place
+// h5 -- arr: Slice to advance
+// h6 -- Returns: false when arr was the last permutation; arr then wraps to the first (sorted)
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+func nextPermutation(arr []int) bool {
+	// Find the rightmost ascent arr[i] < arr[i+1]
+	i := len(arr) - 2
+	for i >= 0 && arr[i] >= arr[i+1] {
+		i--
+	}
+
+	if i < 0 {
+		slices.Reverse(arr) // Descending order is the last permutation
+		return false
+	}
+
+	// Swap arr[i] with the smallest larger element in the descending suffix
+	j := len(arr) - 1
+	for arr[j] <= arr[i] {
+		j--
+	}
+	arr[i], arr[j] = arr[j], arr[i]
+
+	// The suffix is still descending; reversing makes it the smallest arrangement
+	slices.Reverse(arr[i+1:])
+	return true
+}
+
+// h3 -- Combinations Function
+// h4 -- Generates every k-element selection of arr, preserving input order
+// h5 -- arr: Elements to choose from (left unmodified)
+// h5 -- k: Size of each combination
+// h6 -- Returns: C(n,k) combinations; one empty combination when k == 0, none when k < 0 or k > n
+// h6 -- Time Complexity: O(k * C(n,k))
+func combinations[T any](arr []T, k int) [][]T {
+	var result [][]T
+	if k < 0 || k > len(arr) {
+		return result
+	}
+
+	current := make([]T, 0, k)
+	var backtrack func(start int)
+	backtrack = func(start int) {
+		if len(current) == k {
+			result = append(result, slices.Clone(current))
+			return
+		}
+		// Stop early when too few elements remain to fill the combination
+		for i := start; i <= len(arr)-(k-len(current)); i++ {
+			current = append(current, arr[i])  // Choose
+			backtrack(i + 1)                   // Explore
+			current = current[:len(current)-1] // Undo
+		}
+	}
+
+	backtrack(0)
+	return result
+}
+
+// h3 -- Subsets Function
+// h4 -- Enumerates the power set by treating each mask's set bits as chosen indices
+// h5 -- arr: Elements to choose from (fewer than 63 so every mask fits in an int)
+// h6 -- Returns: All 2^n subsets, starting with the empty set
+// h6 -- Time Complexity: O(n * 2^n)
+func subsets[T any](arr []T) [][]T {
+	n := len(arr)
+	result := make([][]T, 0, 1<<n)
+	for mask := 0; mask < 1<<n; mask++ {
+		subset := []T{}
+		for i := 0; i < n; i++ {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, arr[i])
+			}
+		}
+		result = append(result, subset)
+	}
+	return result
+}
+
+// h3 -- N-Queens Solver Function
+// h4 -- Places one queen per row, tracking attacked columns and diagonals in bit masks
+// h5 -- n: Board size
+// h6 -- Returns: Every solution as the queen's column for each row
+// h6 -- Time Complexity: O(n!) upper bound, heavily pruned in practice
+func solveNQueens(n int) [][]int {
+	var solutions [][]int
+	if n <= 0 {
+		return solutions
+	}
+	queens := make([]int, n)
+
+	// cols, diag and anti mark attacked columns for the current row;
+	// diagonals shift by one column per row as they descend
+	var place func(row int, cols, diag, anti uint64)
+	place = func(row int, cols, diag, anti uint64) {
+		if row == n {
+			solutions = append(solutions, slices.Clone(queens))
+			return
+		}
+		for col := 0; col < n; col++ {
+			bit := uint64(1) << col
+			if (cols|diag|anti)&bit != 0 {
+				continue // Square is under attack
+			}
+			queens[row] = col
+			place(row+1, cols|bit, (diag|bit)<<1, (anti|bit)>>1)
+		}
+	}
+
+	place(0, 0, 0, 0)
+	return solutions
+}
+
+// h3 -- N-Queens Counter Function
+// h4 -- Counts solutions by iterating only over free squares with lowest-set-bit tricks
+// h5 -- n: Board size (at most 63)
+// h6 -- Returns: Number of distinct solutions
+// h6 -- Note: Avoids storing boards, so it is much faster than solveNQueens
+func countNQueens(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	full := uint64(1)<<n - 1
+
+	var count func(cols, diag, anti uint64) int
+	count = func(cols, diag, anti uint64) int {
+		if cols == full {
+			return 1 // Every row received a queen
+		}
+		total := 0
+		free := full &^ (cols | diag | anti)
+		for free != 0 {
+			bit := free & -free // Lowest free square
+			free &^= bit
+			total += count(cols|bit, (diag|bit)<<1&full, (anti|bit)>>1)
+		}
+		return total
+	}
+
+	return count(0, 0, 0)
+}
+
+func main() {
+	fmt.Println("=== BACKTRACKING ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Permutations
+	// h4 -- Lists every ordering of a small slice
+	fmt.Println("1. PERMUTATIONS")
+	fmt.Println("===============")
+	perms := permutations([]string{"a", "b", "c"})
+	fmt.Printf("Permutations of [a b c] (%d): %v\n", len(perms), perms)
+
+	// h3 -- Next Permutation
+	// h4 -- Cycles through all n! arrangements and checks the wrap-around
+	fmt.Println("\n2. NEXT PERMUTATION")
+	fmt.Println("===================")
+	arr := []int{1, 2, 3, 4}
+	count := 1
+	for nextPermutation(arr) {
+		count++
+	}
+	fmt.Printf("Arrangements of 4 elements visited: %d (expected: 24)\n", count)
+	fmt.Printf("After the last permutation: %v (wrapped to first)\n", arr)
+
+	dup := []int{1, 1, 2}
+	fmt.Printf("Distinct orderings of %v:", dup)
+	for ok := true; ok; ok = nextPermutation(dup) {
+		fmt.Printf(" %v", dup)
+	}
+	fmt.Println()
+
+	// h3 -- Combinations and Subsets
+	// h4 -- Checks result counts against C(n,k) and 2^n
+	fmt.Println("\n3. COMBINATIONS AND SUBSETS")
+	fmt.Println("===========================")
+	items := []int{1, 2, 3, 4}
+	for _, k := range []int{0, 2, 4, 5} {
+		combos := combinations(items, k)
+		fmt.Printf("C(4,%d) = %d: %v\n", k, len(combos), combos)
+	}
+	subs := subsets([]string{"x", "y", "z"})
+	fmt.Printf("Subsets of [x y z] (%d): %v\n", len(subs), subs)
+
+	// h3 -- N-Queens
+	// h4 -- Compares both solvers with the known solution counts
+	fmt.Println("\n4. N-QUEENS")
+	fmt.Println("===========")
+	known := []int{1, 0, 0, 2, 10, 4, 40, 92, 352, 724}
+	for n := 1; n <= len(known); n++ {
+		fmt.Printf("n=%2d: solutions %4d, counted %4d (expected: %d)\n",
+			n, len(solveNQueens(n)), countNQueens(n), known[n-1])
+	}
+	fmt.Printf("First 8-queens solution: %v\n", solveNQueens(8)[0])
+}
@@ -0,0 +1,60 @@
+// h1 -- Comparator Combinators in Go
+// h2 -- Builds composite less functions for sorts, trees and heaps
+// h2 -- Avoids hand-writing multi-field comparisons at every call site
+
+package cmp
+
+import stdcmp "cmp"
+
+// h3 -- Less Type
+// h4 -- Strict ordering shared by every comparator in this package
+// h6 -- Returns: true when a must come before b
+type Less[T any] func(a, b T) bool
+
+// h3 -- Comparable Interface
+// h4 -- Implemented by types that know how to order themselves
+// h6 -- Less reports whether the receiver comes before other
+type Comparable[T any] interface {
+	Less(other T) bool
+}
+
+// h3 -- Natural Order Function
+// h4 -- Adapts a Comparable type to a Less function
+// h6 -- Returns: Comparator calling a.Less(b)
+func Natural[T Comparable[T]]() Less[T] {
+	return func(a, b T) bool { return a.Less(b) }
+}
+
+// h3 -- Reverse Function
+// h4 -- Flips a comparator so the largest elements come first
+// h5 -- less: Comparator to invert
+// h6 -- Returns: Comparator ordering b before a whenever less(a, b)
+func Reverse[T any](less Less[T]) Less[T] {
+	return func(a, b T) bool { return less(b, a) }
+}
+
+// h3 -- By Key Function
+// h4 -- Orders elements by an ordered key extracted from each one
+// h5 -- keyFn: Extracts the sort key (called twice per comparison)
+// h6 -- Returns: Comparator ordering by keyFn(a) < keyFn(b)
+func ByKey[T any, K stdcmp.Ordered](keyFn func(T) K) Less[T] {
+	return func(a, b T) bool { return keyFn(a) < keyFn(b) }
+}
+
+// h3 -- Then Function
+// h4 -- Chains comparators: secondary breaks ties left by primary
+// h5 -- primary: Comparator consulted first
+// h5 -- secondary: Comparator consulted when primary finds a and b equal
+// h6 -- Returns: Lexicographic combination of both comparators
+// h6 -- Note: Two elements tie under primary when neither is less than the other
+func Then[T any](primary, secondary Less[T]) Less[T] {
+	return func(a, b T) bool {
+		if primary(a, b) {
+			return true
+		}
+		if primary(b, a) {
+			return false
+		}
+		return secondary(a, b)
+	}
+}
@@ -0,0 +1,82 @@
+package cmp
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func age(p person) int     { return p.age }
+func name(p person) string { return p.name }
+
+// sortBy sorts a copy of people with less, so each case starts from the same order.
+func sortBy(people []person, less Less[person]) []person {
+	sorted := slices.Clone(people)
+	sort.Slice(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	return sorted
+}
+
+var people = []person{
+	{"Carol", 30},
+	{"alice", 25},
+	{"Bob", 30},
+	{"Dave", 25},
+	{"Alice", 30},
+}
+
+func TestThenByAgeThenName(t *testing.T) {
+	got := sortBy(people, Then(ByKey(age), ByKey(name)))
+	want := []person{
+		{"Dave", 25}, // Byte order puts upper case before lower case
+		{"alice", 25},
+		{"Alice", 30},
+		{"Bob", 30},
+		{"Carol", 30},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	got := sortBy(people, Then(Reverse(ByKey(age)), ByKey(name)))
+	want := []person{
+		{"Alice", 30},
+		{"Bob", 30},
+		{"Carol", 30},
+		{"Dave", 25},
+		{"alice", 25},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("oldest first: got %v, want %v", got, want)
+	}
+
+	less := ByKey(age)
+	a, b := person{"x", 1}, person{"y", 2}
+	if !Reverse(less)(b, a) || Reverse(less)(a, b) {
+		t.Error("Reverse did not swap the arguments")
+	}
+	if Reverse(less)(a, a) {
+		t.Error("Reverse made an element less than itself")
+	}
+}
+
+type version struct{ major, minor int }
+
+func (v version) Less(other version) bool {
+	return v.major < other.major || (v.major == other.major && v.minor < other.minor)
+}
+
+func TestNatural(t *testing.T) {
+	versions := []version{{1, 10}, {0, 9}, {1, 2}}
+	less := Natural[version]()
+	sort.Slice(versions, func(i, j int) bool { return less(versions[i], versions[j]) })
+	if want := []version{{0, 9}, {1, 2}, {1, 10}}; !slices.Equal(versions, want) {
+		t.Errorf("got %v, want %v", versions, want)
+	}
+}
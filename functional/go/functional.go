@@ -0,0 +1,66 @@
+// h1 -- Functional Slice Helpers in Go
+// h2 -- Generic map/filter/reduce building blocks for slices
+// h2 -- Compose them to express transforms and folds without hand-written loops
+
+package functional
+
+// h3 -- Map Function
+// h4 -- Applies f to every element and collects the results in order
+// h5 -- arr: Input slice
+// h5 -- f: Transformation applied to each element
+// h6 -- Returns: New slice of the same length holding f(arr[i])
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func Map[T, R any](arr []T, f func(T) R) []R {
+	result := make([]R, len(arr))
+	for i, v := range arr {
+		result[i] = f(v)
+	}
+	return result
+}
+
+// h3 -- Filter Function
+// h4 -- Keeps the elements for which pred returns true, preserving order
+// h5 -- arr: Input slice (left unmodified)
+// h5 -- pred: Predicate deciding whether an element is kept
+// h6 -- Returns: New slice with the matching elements
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func Filter[T any](arr []T, pred func(T) bool) []T {
+	result := make([]T, 0, len(arr))
+	for _, v := range arr {
+		if pred(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// h3 -- Reduce Function
+// h4 -- Folds the slice left to right into a single accumulated value
+// h5 -- arr: Input slice
+// h5 -- init: Starting value of the accumulator
+// h5 -- f: Combines the accumulator with the next element
+// h6 -- Returns: Final accumulator (init for an empty slice)
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+func Reduce[T, R any](arr []T, init R, f func(R, T) R) R {
+	acc := init
+	for _, v := range arr {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// h3 -- Group By Function
+// h4 -- Partitions the slice into buckets keyed by keyFn
+// h5 -- arr: Input slice
+// h5 -- keyFn: Computes the group key of each element
+// h6 -- Returns: Map from key to that group's elements in their original order
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+// h6 -- Note: An empty input yields an empty (non-nil) map
+func GroupBy[T any, K comparable](arr []T, keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range arr {
+		key := keyFn(v)
+		groups[key] = append(groups[key], v)
+	}
+	return groups
+}
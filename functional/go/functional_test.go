@@ -0,0 +1,102 @@
+package functional
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func isEven(v int) bool  { return v%2 == 0 }
+func square(v int) int   { return v * v }
+func add(acc, v int) int { return acc + v }
+
+func sumOfEvenSquares(arr []int) int {
+	return Reduce(Map(Filter(arr, isEven), square), 0, add)
+}
+
+func TestComposition(t *testing.T) {
+	tests := []struct {
+		name string
+		arr  []int
+		want int
+	}{
+		{"mixed", []int{1, 2, 3, 4, 5, 6}, 4 + 16 + 36},
+		{"negative evens", []int{-2, -3, 4}, 4 + 16},
+		{"no evens", []int{1, 3, 5}, 0},
+		{"empty", []int{}, 0},
+		{"nil", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sumOfEvenSquares(tt.arr); got != tt.want {
+				t.Errorf("sum of squares of evens in %v = %d, want %d", tt.arr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapFilterReduce(t *testing.T) {
+	arr := []int{3, 1, 4, 1, 5}
+	if got := Map(arr, strconv.Itoa); !slices.Equal(got, []string{"3", "1", "4", "1", "5"}) {
+		t.Errorf("Map changed type or order: %v", got)
+	}
+	if got := Filter(arr, func(v int) bool { return v > 1 }); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Filter = %v, want [3 4 5]", got)
+	}
+	if !slices.Equal(arr, []int{3, 1, 4, 1, 5}) {
+		t.Errorf("input modified: %v", arr)
+	}
+	// Reduce folds left to right, so a non-commutative step shows the order
+	digits := Reduce(arr, "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	if digits != "31415" {
+		t.Errorf("Reduce concatenation = %q, want %q", digits, "31415")
+	}
+
+	for _, empty := range [][]int{nil, {}} {
+		if got := Map(empty, square); got == nil || len(got) != 0 {
+			t.Errorf("Map(%#v) = %#v, want an empty slice", empty, got)
+		}
+		if got := Filter(empty, isEven); got == nil || len(got) != 0 {
+			t.Errorf("Filter(%#v) = %#v, want an empty slice", empty, got)
+		}
+		if got := Reduce(empty, 7, add); got != 7 {
+			t.Errorf("Reduce(%#v) = %d, want the initial value 7", empty, got)
+		}
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	parity := GroupBy([]int{5, 2, 8, 3, 1, 4}, func(v int) string {
+		if isEven(v) {
+			return "even"
+		}
+		return "odd"
+	})
+	if len(parity) != 2 ||
+		!slices.Equal(parity["even"], []int{2, 8, 4}) ||
+		!slices.Equal(parity["odd"], []int{5, 3, 1}) {
+		t.Errorf("GroupBy parity = %v, want even [2 8 4] and odd [5 3 1] in input order", parity)
+	}
+
+	words := []string{"banana", "apple", "blueberry", "cherry", "avocado"}
+	byLetter := GroupBy(words, func(s string) byte { return s[0] })
+	want := map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"banana", "blueberry"},
+		'c': {"cherry"},
+	}
+	if len(byLetter) != len(want) {
+		t.Errorf("GroupBy first letter has %d groups, want %d", len(byLetter), len(want))
+	}
+	for letter, group := range want {
+		if !slices.Equal(byLetter[letter], group) {
+			t.Errorf("group %q = %v, want %v in input order", letter, byLetter[letter], group)
+		}
+	}
+
+	for _, empty := range [][]int{nil, {}} {
+		if got := GroupBy(empty, square); got == nil || len(got) != 0 {
+			t.Errorf("GroupBy(%#v) = %#v, want an empty non-nil map", empty, got)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+// h1 -- Graph Algorithms Implementation in Go
+// h2 -- Adjacency-list graphs over integer vertices
+// h2 -- Includes topological sorting and a named build-order wrapper
+// h2 -- Breadth-first search finds shortest word ladders over an implicit graph
+
+package main
+
+import (
+	"fmt"
+)
+
+// h3 -- Topological Sort Function (Kahn's Algorithm)
+// h4 -- Repeatedly emits vertices whose incoming edges have all been emitted
+// h5 -- n: Number of vertices, labelled 0..n-1
+// h5 -- adj: adj[u] lists every v with an edge u -> v
+// h6 -- Returns: Vertex order and true, or a partial order and false when a cycle exists
+// h6 -- Time Complexity: O(V + E), Space Complexity: O(V)
+func topologicalSort(n int, adj [][]int) ([]int, bool) {
+	inDegree := make([]int, n)
+	for u := 0; u < n; u++ {
+		for _, v := range adj[u] {
+			inDegree[v]++
+		}
+	}
+
+	// FIFO queue of ready vertices keeps the output close to input order
+	queue := make([]int, 0, n)
+	for v := 0; v < n; v++ {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]int, 0, n)
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		order = append(order, u)
+		for _, v := range adj[u] {
+			inDegree[v]--
+			if inDegree[v] == 0 {
+				queue = append(queue, v)
+			}
+		}
+	}
+	return order, len(order) == n
+}
+
+// h3 -- Cycle Member Function
+// h4 -- Finds a vertex lying on a cycle among the vertices a topological sort could not emit
+// h5 -- emitted: Vertices output by topologicalSort
+// h6 -- Returns: A vertex on a cycle, or -1 when every vertex was emitted
+// h6 -- Note: Every unemitted vertex has an unemitted predecessor, so walking
+// h6 --       predecessors n times must end inside a cycle
+func cycleMember(n int, adj [][]int, emitted []int) int {
+	done := make([]bool, n)
+	for _, v := range emitted {
+		done[v] = true
+	}
+
+	pred := make([]int, n)
+	for i := range pred {
+		pred[i] = -1
+	}
+	start := -1
+	for u := 0; u < n; u++ {
+		if done[u] {
+			continue
+		}
+		if start == -1 {
+			start = u
+		}
+		for _, v := range adj[u] {
+			if !done[v] && pred[v] == -1 {
+				pred[v] = u
+			}
+		}
+	}
+	if start == -1 {
+		return -1
+	}
+
+	v := start
+	for i := 0; i < n; i++ {
+		v = pred[v]
+	}
+	return v
+}
+
+// h3 -- Cycle Error Type
+// h4 -- Reported by buildOrder when dependencies are circular
+// h5 -- Project: One project that lies on the cycle
+type CycleError struct {
+	Project string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("dependency cycle involving project %q", e.Project)
+}
+
+// h3 -- Build Order Function
+// h4 -- String-keyed wrapper that maps project names onto the integer graph
+// h5 -- projects: Every project name (order breaks ties in the result)
+// h5 -- deps: Pairs {before, after}: before must be built before after
+// h6 -- Returns: Valid build order, or an error for unknown names or a cycle (*CycleError)
+// h6 -- Time Complexity: O(P + D)
+func buildOrder(projects []string, deps [][2]string) ([]string, error) {
+	ids := make(map[string]int, len(projects))
+	for i, name := range projects {
+		if _, dup := ids[name]; dup {
+			return nil, fmt.Errorf("duplicate project %q", name)
+		}
+		ids[name] = i
+	}
+
+	adj := make([][]int, len(projects))
+	for _, dep := range deps {
+		before, ok := ids[dep[0]]
+		if !ok {
+			return nil, fmt.Errorf("dependency on unknown project %q", dep[0])
+		}
+		after, ok := ids[dep[1]]
+		if !ok {
+			return nil, fmt.Errorf("dependency on unknown project %q", dep[1])
+		}
+		adj[before] = append(adj[before], after)
+	}
+
+	order, ok := topologicalSort(len(projects), adj)
+	if !ok {
+		return nil, &CycleError{Project: projects[cycleMember(len(projects), adj, order)]}
+	}
+
+	names := make([]string, len(order))
+	for i, id := range order {
+		names[i] = projects[id]
+	}
+	return names, nil
+}
+
+// h3 -- Course Order Function
+// h4 -- Course-scheduling front end for topologicalSort
+// h5 -- numCourses: Courses are labelled 0..numCourses-1
+// h5 -- prerequisites: Pairs {course, prerequisite}: prerequisite must be taken first
+// h6 -- Returns: A valid completion order, or an error for an unknown course or a cycle
+// h6 -- Time Complexity: O(V + E)
+func courseOrder(numCourses int, prerequisites [][2]int) ([]int, error) {
+	adj := make([][]int, numCourses)
+	for _, p := range prerequisites {
+		course, prereq := p[0], p[1]
+		if course < 0 || course >= numCourses || prereq < 0 || prereq >= numCourses {
+			return nil, fmt.Errorf("prerequisite %v names a course outside [0, %d)", p, numCourses)
+		}
+		adj[prereq] = append(adj[prereq], course)
+	}
+
+	order, ok := topologicalSort(numCourses, adj)
+	if !ok {
+		return nil, fmt.Errorf("prerequisite cycle involving course %d", cycleMember(numCourses, adj, order))
+	}
+	return order, nil
+}
+
+// h3 -- Can Finish Courses Function
+// h6 -- Returns: true if every course can be completed, i.e. courseOrder succeeds
+func canFinishCourses(numCourses int, prerequisites [][2]int) bool {
+	_, err := courseOrder(numCourses, prerequisites)
+	return err == nil
+}
+
+// h3 -- Word Ladder Function (Breadth-First Search)
+// h4 -- Shortest chain from begin to end changing one letter per step through dictionary words
+// h5 -- wordList: Valid intermediate words; end must be one of them, begin need not be
+// h5 -- buckets: Words grouped by wildcard pattern ("h*t" holds "hot", "hit", ...), so
+// h5 --          neighbours differing in exactly one position share a bucket
+// h6 -- Returns: Number of words in the shortest chain including begin and end, or 0 if none
+// h6 -- Note: Letters are compared byte by byte, so words are expected to be ASCII
+// h6 -- Time Complexity: O(N * L²) for N words of length L
+func wordLadder(begin, end string, wordList []string) int {
+	buckets := map[string][]string{}
+	hasEnd := false
+	for _, word := range wordList {
+		if len(word) != len(begin) {
+			continue // Can never be reached by single-letter changes
+		}
+		hasEnd = hasEnd || word == end
+		for i := range word {
+			pattern := word[:i] + "*" + word[i+1:]
+			buckets[pattern] = append(buckets[pattern], word)
+		}
+	}
+	if !hasEnd {
+		return 0
+	}
+
+	// Level-by-level BFS; the first time end is dequeued its distance is minimal
+	dist := map[string]int{begin: 1}
+	queue := []string{begin}
+	for len(queue) > 0 {
+		word := queue[0]
+		queue = queue[1:]
+		if word == end {
+			return dist[word]
+		}
+		for i := range word {
+			pattern := word[:i] + "*" + word[i+1:]
+			for _, next := range buckets[pattern] {
+				if _, seen := dist[next]; !seen {
+					dist[next] = dist[word] + 1
+					queue = append(queue, next)
+				}
+			}
+			delete(buckets, pattern) // Every word in the bucket is now queued
+		}
+	}
+	return 0
+}
+
+func main() {
+	fmt.Println("=== GRAPH ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Build Order
+	// h4 -- Resolves a solvable dependency set and reports a circular one
+	fmt.Println("1. BUILD ORDER")
+	fmt.Println("==============")
+
+	projects := []string{"app", "ui", "api", "db", "log"}
+	deps := [][2]string{
+		{"db", "api"},
+		{"log", "db"},
+		{"api", "app"},
+		{"ui", "app"},
+	}
+	order, err := buildOrder(projects, deps)
+	fmt.Printf("Projects: %v\n", projects)
+	fmt.Printf("Build order: %v (error: %v)\n", order, err)
+
+	cyclic := append(deps[:len(deps):len(deps)], [2]string{"app", "log"})
+	_, err = buildOrder(projects, cyclic)
+	fmt.Printf("With app -> log added: %v\n", err)
+	_, err = buildOrder([]string{"ui", "app"}, [][2]string{{"ui", "app"}, {"ui", "ui"}})
+	fmt.Printf("With a self-dependency: %v\n", err)
+
+	// h3 -- Course Schedule
+	// h4 -- A satisfiable prerequisite set, a circular one, and an unknown course
+	fmt.Println("\n2. COURSE SCHEDULE")
+	fmt.Println("==================")
+
+	schedules := []struct {
+		name          string
+		numCourses    int
+		prerequisites [][2]int
+	}{
+		{"satisfiable", 6, [][2]int{{1, 0}, {2, 0}, {3, 1}, {3, 2}, {5, 4}}},
+		{"circular", 4, [][2]int{{1, 0}, {2, 1}, {3, 2}, {1, 3}}},
+		{"unknown course", 2, [][2]int{{2, 0}}},
+		{"no prerequisites", 3, nil},
+	}
+	for _, sc := range schedules {
+		order, err := courseOrder(sc.numCourses, sc.prerequisites)
+		fmt.Printf("  %-16s can finish: %-5t order: %v (error: %v)\n",
+			sc.name, canFinishCourses(sc.numCourses, sc.prerequisites), order, err)
+	}
+
+	// h3 -- Word Ladder
+	// h4 -- Reachable and unreachable targets, and a target missing from the dictionary
+	fmt.Println("\n3. WORD LADDER")
+	fmt.Println("==============")
+
+	words := []string{"hot", "dot", "dog", "lot", "log", "cog"}
+	ladders := []struct {
+		begin, end string
+		wordList   []string
+		want       int
+	}{
+		{"hit", "cog", words, 5},                    // hit -> hot -> dot -> dog -> cog
+		{"hit", "hot", words, 2},                    // One step
+		{"hot", "hot", words, 1},                    // Already there
+		{"hit", "cog", words[:5], 0},                // cog not in the word list
+		{"hit", "cat", append(words, "cat"), 0},     // cat has no neighbours
+		{"hit", "hits", []string{"hits", "hot"}, 0}, // Different lengths never connect
+	}
+	for _, l := range ladders {
+		got := wordLadder(l.begin, l.end, l.wordList)
+		status := "✓"
+		if got != l.want {
+			status = "✗"
+		}
+		fmt.Printf("  %s %s -> %s: %d (expected %d)\n", status, l.begin, l.end, got, l.want)
+	}
+}
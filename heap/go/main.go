@@ -0,0 +1,915 @@
+// h1 -- Heap Applications in Go
+// h2 -- Priority-queue based structures built on container/heap
+// h2 -- Includes streaming statistics and a comparison against sorting
+
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"time"
+)
+
+// h3 -- Integer Min-Heap Type
+// h4 -- Implements heap.Interface so container/heap can maintain the ordering
+type intMinHeap []int
+
+func (h intMinHeap) Len() int           { return len(h) }
+func (h intMinHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intMinHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intMinHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intMinHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// h3 -- Kth Largest Stream Type
+// h4 -- Keeps the k largest values seen so far in a size-k min-heap
+// h6 -- The heap root is always the current k-th largest value
+type KthLargestStream struct {
+	k    int
+	heap intMinHeap
+}
+
+// h3 -- Kth Largest Stream Constructor
+// h5 -- k: Rank to track (must be positive)
+// h5 -- nums: Initial values fed through Add
+// h6 -- Panics: when k is not positive
+func NewKthLargestStream(k int, nums []int) *KthLargestStream {
+	if k <= 0 {
+		panic(fmt.Sprintf("KthLargestStream: k must be positive, got %d", k))
+	}
+	s := &KthLargestStream{k: k, heap: make(intMinHeap, 0, k)}
+	for _, v := range nums {
+		s.Add(v)
+	}
+	return s
+}
+
+// h3 -- Add Function
+// h4 -- Inserts v and reports the k-th largest value seen so far
+// h6 -- Returns: Heap root; while fewer than k values were added this is the smallest of them
+// h6 -- Time Complexity: O(log k), Space Complexity: O(k) overall
+func (s *KthLargestStream) Add(v int) int {
+	if s.heap.Len() < s.k {
+		heap.Push(&s.heap, v)
+	} else if v > s.heap[0] {
+		// Replace the smallest retained value and restore the heap property
+		s.heap[0] = v
+		heap.Fix(&s.heap, 0)
+	}
+	return s.heap[0]
+}
+
+// h3 -- Reference Kth Largest Function
+// h4 -- Sorts a copy of the values and picks the k-th largest directly
+// h6 -- Time Complexity: O(n log n) - used only to validate the stream
+func kthLargestBySorting(values []int, k int) int {
+	sorted := append([]int(nil), values...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sorted)))
+	if k > len(sorted) {
+		k = len(sorted)
+	}
+	return sorted[k-1]
+}
+
+// h3 -- Running Median Type
+// h4 -- Splits the values seen so far into two halves kept in heaps
+// h5 -- lower: Max-heap of the smaller half, stored negated in a min-heap
+// h5 -- upper: Min-heap of the larger half
+// h6 -- Invariant: len(lower) == len(upper) or len(lower) == len(upper)+1
+type RunningMedian struct {
+	lower intMinHeap
+	upper intMinHeap
+}
+
+// h3 -- Add Function
+// h4 -- Inserts v into the proper half, then rebalances the heap sizes
+// h6 -- Time Complexity: O(log n)
+func (m *RunningMedian) Add(v int) {
+	if m.lower.Len() == 0 || v <= -m.lower[0] {
+		heap.Push(&m.lower, -v)
+	} else {
+		heap.Push(&m.upper, v)
+	}
+
+	// Restore the size invariant by moving one root across
+	if m.lower.Len() > m.upper.Len()+1 {
+		heap.Push(&m.upper, -heap.Pop(&m.lower).(int))
+	} else if m.upper.Len() > m.lower.Len() {
+		heap.Push(&m.lower, -heap.Pop(&m.upper).(int))
+	}
+}
+
+// h3 -- Median Function
+// h4 -- Reads the median from the heap roots
+// h6 -- Returns: Middle value (odd count), mean of the two middles (even count), NaN when empty
+// h6 -- Time Complexity: O(1)
+func (m *RunningMedian) Median() float64 {
+	switch {
+	case m.lower.Len() == 0:
+		return math.NaN()
+	case m.lower.Len() > m.upper.Len():
+		return float64(-m.lower[0])
+	default:
+		return (float64(-m.lower[0]) + float64(m.upper[0])) / 2
+	}
+}
+
+// h3 -- Reference Median Function
+// h4 -- Sorts a copy of the values and reads the middle directly
+// h6 -- Time Complexity: O(n log n) - used only to validate RunningMedian
+func medianBySorting(values []int) float64 {
+	sorted := append([]int(nil), values...)
+	sort.Ints(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return float64(sorted[n/2])
+	}
+	return (float64(sorted[n/2-1]) + float64(sorted[n/2])) / 2
+}
+
+// h3 -- Worst-First Heap Type
+// h4 -- Generic heap whose root is the element that comes last under less
+// h6 -- Lets TopN find the element to evict in O(1)
+type worstFirstHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h worstFirstHeap[T]) Len() int           { return len(h.items) }
+func (h worstFirstHeap[T]) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h worstFirstHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *worstFirstHeap[T]) Push(x any)        { h.items = append(h.items, x.(T)) }
+func (h *worstFirstHeap[T]) Pop() any {
+	n := len(h.items)
+	x := h.items[n-1]
+	h.items = h.items[:n-1]
+	return x
+}
+
+// h3 -- Top-N Accumulator Type
+// h4 -- Streams elements and keeps only the n that sort first under less
+// h6 -- Memory stays O(n) no matter how many elements are added
+// h6 -- Pass a greater-than comparator to keep the n largest values
+type TopN[T any] struct {
+	n    int
+	heap worstFirstHeap[T]
+}
+
+// h3 -- Top-N Constructor
+// h5 -- n: Number of elements to retain
+// h5 -- less: Ordering; retained elements are the first n of the sorted stream
+func NewTopN[T any](n int, less func(a, b T) bool) *TopN[T] {
+	return &TopN[T]{n: n, heap: worstFirstHeap[T]{items: make([]T, 0, max(n, 0)), less: less}}
+}
+
+// h3 -- Add Function
+// h4 -- Offers v; it replaces the worst retained element when it sorts before it
+// h6 -- Time Complexity: O(log n)
+func (t *TopN[T]) Add(v T) {
+	if t.n <= 0 {
+		return
+	}
+	if t.heap.Len() < t.n {
+		heap.Push(&t.heap, v)
+	} else if t.heap.less(v, t.heap.items[0]) {
+		t.heap.items[0] = v
+		heap.Fix(&t.heap, 0)
+	}
+}
+
+// h3 -- Result Function
+// h6 -- Returns: Copy of the retained elements sorted by less
+// h6 -- Time Complexity: O(n log n)
+func (t *TopN[T]) Result() []T {
+	result := slices.Clone(t.heap.items)
+	sort.SliceStable(result, func(i, j int) bool { return t.heap.less(result[i], result[j]) })
+	return result
+}
+
+// h3 -- Task Type
+// h4 -- Unit of work handed to the Scheduler
+// h5 -- Priority: Lower values run first
+// h5 -- Deadline: Task is discarded once this passes; zero means no deadline
+type Task struct {
+	Name     string
+	Priority int
+	Deadline time.Time
+}
+
+// h3 -- Scheduled Task Type
+// h4 -- Wraps a task with its insertion sequence number for stable ordering
+type scheduledTask struct {
+	task Task
+	seq  int
+}
+
+// h3 -- Task Min-Heap Type
+// h4 -- Orders by priority, then by insertion order among equal priorities
+type taskHeap []scheduledTask
+
+func (h taskHeap) Len() int { return len(h) }
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].task.Priority != h[j].task.Priority {
+		return h[i].task.Priority < h[j].task.Priority
+	}
+	return h[i].seq < h[j].seq
+}
+func (h taskHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *taskHeap) Push(x any)   { *h = append(*h, x.(scheduledTask)) }
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// h3 -- Scheduler Type
+// h4 -- Hands out the highest-priority task that has not missed its deadline
+// h5 -- expired: Tasks skipped by Next because their deadline passed
+// h5 -- now: Clock source, injectable for deterministic testing
+type Scheduler struct {
+	tasks   taskHeap
+	nextSeq int
+	expired []Task
+	now     func() time.Time
+}
+
+// h3 -- Scheduler Constructor
+// h5 -- now: Clock source; nil uses time.Now
+func NewScheduler(now func() time.Time) *Scheduler {
+	if now == nil {
+		now = time.Now
+	}
+	return &Scheduler{now: now}
+}
+
+// h3 -- Add Function
+// h4 -- Queues a task in O(log n)
+func (s *Scheduler) Add(task Task) {
+	heap.Push(&s.tasks, scheduledTask{task: task, seq: s.nextSeq})
+	s.nextSeq++
+}
+
+// h3 -- Next Function
+// h4 -- Pops tasks in priority order (FIFO among ties), discarding expired ones
+// h6 -- Returns: The task and true, or false when no ready task remains
+// h6 -- Time Complexity: O(log n) per popped task
+func (s *Scheduler) Next() (Task, bool) {
+	for s.tasks.Len() > 0 {
+		next := heap.Pop(&s.tasks).(scheduledTask).task
+		if !next.Deadline.IsZero() && s.now().After(next.Deadline) {
+			s.expired = append(s.expired, next)
+			continue
+		}
+		return next, true
+	}
+	return Task{}, false
+}
+
+// h3 -- Expired Function
+// h6 -- Returns: Tasks discarded so far because they missed their deadline
+func (s *Scheduler) Expired() []Task {
+	return s.expired
+}
+
+// h3 -- Pending Function
+// h6 -- Returns: Number of queued tasks, including any not yet found to be expired
+func (s *Scheduler) Pending() int {
+	return s.tasks.Len()
+}
+
+// h3 -- Backoff Policy Type
+// h4 -- Delay before retry n is Initial * Multiplier^(n-1), capped at Max
+// h5 -- MaxAttempts: Failures tolerated before a task is dropped; 0 means unlimited
+type BackoffPolicy struct {
+	Initial     time.Duration
+	Multiplier  float64
+	Max         time.Duration
+	MaxAttempts int
+}
+
+// h3 -- Delay Function
+// h6 -- Returns: Backoff before the given retry (1-based)
+// h6 -- Note: Without Max the delay saturates at the largest Duration; converting a
+// h6 --       larger float would wrap negative and retry the task immediately
+func (p BackoffPolicy) Delay(retry int) time.Duration {
+	if p.Initial <= 0 {
+		return 0 // Also avoids 0 * Inf = NaN once the power overflows
+	}
+	d := float64(p.Initial) * math.Pow(p.Multiplier, float64(retry-1))
+	if p.Max > 0 && d > float64(p.Max) {
+		return p.Max
+	}
+	if d >= math.MaxInt64 { // float64(MaxInt64) rounds up to 2^63, itself out of range
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// h3 -- Retry Entry Type
+// h4 -- Task waiting for its next attempt, FIFO among equal times
+type retryEntry struct {
+	task Task
+	at   time.Time
+	seq  int
+}
+
+// h3 -- Retry Min-Heap Type
+// h4 -- Orders by next attempt time, then by insertion order
+type retryHeap []retryEntry
+
+func (h retryHeap) Len() int { return len(h) }
+func (h retryHeap) Less(i, j int) bool {
+	if !h[i].at.Equal(h[j].at) {
+		return h[i].at.Before(h[j].at)
+	}
+	return h[i].seq < h[j].seq
+}
+func (h retryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *retryHeap) Push(x any)   { *h = append(*h, x.(retryEntry)) }
+func (h *retryHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// h3 -- Retry Queue Type
+// h4 -- Holds failed tasks until their exponential backoff has elapsed
+// h5 -- failures: Consecutive failures per task name, reset by Succeed
+// h6 -- Note: Tasks are identified by Name, so names must be unique among tasks in flight;
+// h6 --       two tasks sharing a name would share one failure count and backoff
+// h5 -- dropped: Tasks that exhausted MaxAttempts
+// h5 -- now: Clock source, injectable for deterministic testing
+type RetryQueue struct {
+	policy   BackoffPolicy
+	waiting  retryHeap
+	nextSeq  int
+	failures map[string]int
+	dropped  []Task
+	now      func() time.Time
+}
+
+// h3 -- Retry Queue Constructor
+// h5 -- now: Clock source; nil uses time.Now
+func NewRetryQueue(policy BackoffPolicy, now func() time.Time) *RetryQueue {
+	if now == nil {
+		now = time.Now
+	}
+	return &RetryQueue{policy: policy, failures: map[string]int{}, now: now}
+}
+
+// h3 -- Fail Function
+// h4 -- Records a failure and schedules the next attempt after the backoff delay
+// h6 -- Returns: Time of the next attempt and true, or false if the task was dropped
+// h6 -- Time Complexity: O(log n)
+func (q *RetryQueue) Fail(task Task) (time.Time, bool) {
+	q.failures[task.Name]++
+	n := q.failures[task.Name]
+	if q.policy.MaxAttempts > 0 && n > q.policy.MaxAttempts {
+		delete(q.failures, task.Name)
+		q.dropped = append(q.dropped, task)
+		return time.Time{}, false
+	}
+	at := q.now().Add(q.policy.Delay(n))
+	heap.Push(&q.waiting, retryEntry{task: task, at: at, seq: q.nextSeq})
+	q.nextSeq++
+	return at, true
+}
+
+// h3 -- Succeed Function
+// h4 -- Clears the failure count so a later failure starts from the initial delay
+func (q *RetryQueue) Succeed(task Task) {
+	delete(q.failures, task.Name)
+}
+
+// h3 -- Ready Function
+// h4 -- Removes and returns every task whose retry time is at or before now
+// h6 -- Returns: Tasks in retry-time order
+// h6 -- Time Complexity: O(k log n) for k ready tasks
+func (q *RetryQueue) Ready(now time.Time) []Task {
+	var ready []Task
+	for q.waiting.Len() > 0 && !q.waiting[0].at.After(now) {
+		ready = append(ready, heap.Pop(&q.waiting).(retryEntry).task)
+	}
+	return ready
+}
+
+// h3 -- Retry Queue Accessors
+func (q *RetryQueue) Waiting() int    { return q.waiting.Len() }
+func (q *RetryQueue) Dropped() []Task { return q.dropped }
+
+// h3 -- Event Type
+// h4 -- Something that happens at a point in simulated time
+// h5 -- Time: Simulated timestamp (arbitrary units)
+// h5 -- Kind: Handler-defined event category, e.g. "arrival"
+// h5 -- ID: Handler-defined subject of the event, e.g. a customer number
+type Event struct {
+	Time float64
+	Kind string
+	ID   int
+}
+
+// h3 -- Event Queue Type
+// h4 -- Min-heap ordered by timestamp, FIFO among simultaneous events
+type eventQueue []scheduledEvent
+
+type scheduledEvent struct {
+	event Event
+	seq   int
+}
+
+func (q eventQueue) Len() int { return len(q) }
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].event.Time != q[j].event.Time {
+		return q[i].event.Time < q[j].event.Time
+	}
+	return q[i].seq < q[j].seq
+}
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *eventQueue) Push(x any)   { *q = append(*q, x.(scheduledEvent)) }
+func (q *eventQueue) Pop() any {
+	old := *q
+	n := len(old)
+	x := old[n-1]
+	*q = old[:n-1]
+	return x
+}
+
+// h3 -- Simulator Type
+// h4 -- Discrete-event loop: repeatedly pops the earliest event and hands it to handler
+// h5 -- handler: Reacts to an event and may Schedule future ones
+type Simulator struct {
+	queue   eventQueue
+	nextSeq int
+	now     float64
+	handler func(sim *Simulator, e Event)
+}
+
+// h3 -- Simulator Constructor
+func NewSimulator(handler func(sim *Simulator, e Event)) *Simulator {
+	return &Simulator{handler: handler}
+}
+
+// h3 -- Now Function
+// h6 -- Returns: Timestamp of the event being (or last) processed
+func (s *Simulator) Now() float64 { return s.now }
+
+// h3 -- Schedule Function
+// h4 -- Queues an event in O(log n)
+// h6 -- Returns: Error when the event lies in the simulated past
+func (s *Simulator) Schedule(e Event) error {
+	if e.Time < s.now {
+		return fmt.Errorf("event %q at %g scheduled before current time %g", e.Kind, e.Time, s.now)
+	}
+	heap.Push(&s.queue, scheduledEvent{event: e, seq: s.nextSeq})
+	s.nextSeq++
+	return nil
+}
+
+// h3 -- Run Function
+// h4 -- Processes events in timestamp order until the queue empties or passes until
+// h5 -- until: Time limit; events later than this stay queued
+// h6 -- Returns: Number of events processed
+func (s *Simulator) Run(until float64) int {
+	processed := 0
+	for s.queue.Len() > 0 && s.queue[0].event.Time <= until {
+		e := heap.Pop(&s.queue).(scheduledEvent).event
+		s.now = e.Time
+		s.handler(s, e)
+		processed++
+	}
+	return processed
+}
+
+// h3 -- List Cursor Heap
+// h4 -- Min-heap of positions into k sorted lists, ordered by the value under each cursor
+type listCursor struct {
+	value, list, index int
+}
+
+type cursorHeap []listCursor
+
+func (h cursorHeap) Len() int           { return len(h) }
+func (h cursorHeap) Less(i, j int) bool { return h[i].value < h[j].value }
+func (h cursorHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *cursorHeap) Push(x any)        { *h = append(*h, x.(listCursor)) }
+func (h *cursorHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// h3 -- Smallest Range Function
+// h4 -- Finds the narrowest [lo, hi] containing at least one value from every list
+// h5 -- lists: k non-empty lists, each sorted ascending
+// h6 -- Returns: The range; ties on width go to the smaller lo
+// h6 -- Time Complexity: O(n log k) for n values in total, Space Complexity: O(k)
+// h6 -- Panics: when lists is empty or any list is empty (no range can cover it)
+// h6 -- Note: The heap holds one cursor per list; the window is [heap minimum, largest cursor].
+// h6 --       Only advancing the minimum's list can shrink it, and once that list runs
+// h6 --       out no later window covers every list
+func smallestRange(lists [][]int) [2]int {
+	if len(lists) == 0 {
+		panic("smallestRange: no lists")
+	}
+	h := make(cursorHeap, 0, len(lists))
+	hi := math.MinInt
+	for i, list := range lists {
+		if len(list) == 0 {
+			panic(fmt.Sprintf("smallestRange: list %d is empty", i))
+		}
+		h = append(h, listCursor{value: list[0], list: i})
+		hi = max(hi, list[0])
+	}
+	heap.Init(&h)
+
+	best := [2]int{h[0].value, hi}
+	for {
+		cur := h[0]
+		if cur.index+1 == len(lists[cur.list]) {
+			return best
+		}
+		next := lists[cur.list][cur.index+1]
+		h[0] = listCursor{value: next, list: cur.list, index: cur.index + 1}
+		heap.Fix(&h, 0)
+		hi = max(hi, next)
+		if lo := h[0].value; hi-lo < best[1]-best[0] {
+			best = [2]int{lo, hi}
+		}
+	}
+}
+
+// h3 -- Range Coverage Check
+// h6 -- Returns: true if every list has a value inside [r[0], r[1]]
+func rangeCoversAll(lists [][]int, r [2]int) bool {
+	for _, list := range lists {
+		if !slices.ContainsFunc(list, func(v int) bool { return v >= r[0] && v <= r[1] }) {
+			return false
+		}
+	}
+	return true
+}
+
+// h3 -- Brute-Force Smallest Range
+// h4 -- Tries every pair of values as endpoints
+// h6 -- Time Complexity: O(n³) - reference for small inputs only
+func bruteSmallestRange(lists [][]int) [2]int {
+	var values []int
+	for _, list := range lists {
+		values = append(values, list...)
+	}
+	slices.Sort(values)
+	best := [2]int{values[0], values[len(values)-1]}
+	for i, lo := range values {
+		for _, hi := range values[i:] {
+			r := [2]int{lo, hi}
+			if hi-lo < best[1]-best[0] && rangeCoversAll(lists, r) {
+				best = r
+			}
+		}
+	}
+	return best
+}
+
+// h3 -- Tagged Item Type
+// h4 -- Merged value together with the index of the source slice it came from
+type TaggedItem[T any] struct {
+	Value  T
+	Source int
+}
+
+// h3 -- Tagged Merge Heap
+// h4 -- Min-heap of source cursors; ties go to the lower source index so the merge is stable
+type taggedCursor[T any] struct {
+	item  TaggedItem[T]
+	index int // Position of item.Value within its source
+}
+
+type taggedHeap[T any] struct {
+	items []taggedCursor[T]
+	less  func(a, b T) bool
+}
+
+func (h taggedHeap[T]) Len() int { return len(h.items) }
+func (h taggedHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i].item, h.items[j].item
+	switch {
+	case h.less(a.Value, b.Value):
+		return true
+	case h.less(b.Value, a.Value):
+		return false
+	}
+	return a.Source < b.Source
+}
+func (h taggedHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *taggedHeap[T]) Push(x any)   { h.items = append(h.items, x.(taggedCursor[T])) }
+func (h *taggedHeap[T]) Pop() any {
+	n := len(h.items)
+	x := h.items[n-1]
+	h.items = h.items[:n-1]
+	return x
+}
+
+// h3 -- Tagged K-Way Merge Function
+// h4 -- Merges k sorted slices, recording which source each output element came from
+// h5 -- sources: Slices each sorted by less (empty slices are allowed)
+// h5 -- less: Strict ordering shared by all sources
+// h6 -- Returns: All elements in sorted order; equal elements keep source order, then input order
+// h6 -- Time Complexity: O(n log k), Space Complexity: O(k) beyond the output
+func mergeKTagged[T any](sources [][]T, less func(a, b T) bool) []TaggedItem[T] {
+	h := &taggedHeap[T]{less: less}
+	total := 0
+	for i, src := range sources {
+		total += len(src)
+		if len(src) > 0 {
+			h.items = append(h.items, taggedCursor[T]{item: TaggedItem[T]{Value: src[0], Source: i}})
+		}
+	}
+	heap.Init(h)
+
+	merged := make([]TaggedItem[T], 0, total)
+	for h.Len() > 0 {
+		cur := h.items[0]
+		merged = append(merged, cur.item)
+		src := sources[cur.item.Source]
+		if next := cur.index + 1; next < len(src) {
+			h.items[0] = taggedCursor[T]{item: TaggedItem[T]{Value: src[next], Source: cur.item.Source}, index: next}
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return merged
+}
+
+func main() {
+	fmt.Println("=== HEAP APPLICATIONS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Kth Largest Stream
+	// h4 -- Compares the running k-th largest with a sort after every insertion
+	fmt.Println("1. KTH LARGEST IN A STREAM")
+	fmt.Println("==========================")
+
+	k := 3
+	initial := []int{4, 5, 8, 2}
+	stream := NewKthLargestStream(k, initial)
+	seen := append([]int(nil), initial...)
+	fmt.Printf("k = %d, initial values: %v\n", k, initial)
+
+	for _, v := range []int{3, 5, 10, 9, 4, 1} {
+		seen = append(seen, v)
+		got := stream.Add(v)
+		fmt.Printf("  Add %2d -> %d (reference: %d)\n", v, got, kthLargestBySorting(seen, k))
+	}
+
+	// h3 -- Priority Task Scheduler
+	// h4 -- Equal priorities come out in insertion order; expired tasks are skipped
+	fmt.Println("\n2. PRIORITY TASK SCHEDULER")
+	fmt.Println("==========================")
+
+	clock := time.Unix(1000, 0)
+	scheduler := NewScheduler(func() time.Time { return clock })
+	scheduler.Add(Task{Name: "write report", Priority: 2})
+	scheduler.Add(Task{Name: "fix outage", Priority: 0})
+	scheduler.Add(Task{Name: "review PR", Priority: 1})
+	scheduler.Add(Task{Name: "stale ping", Priority: 0, Deadline: clock.Add(-time.Minute)})
+	scheduler.Add(Task{Name: "answer email", Priority: 2})
+	scheduler.Add(Task{Name: "deploy", Priority: 1, Deadline: clock.Add(time.Hour)})
+
+	for {
+		task, ok := scheduler.Next()
+		if !ok {
+			break
+		}
+		fmt.Printf("  Run: %-13s (priority %d)\n", task.Name, task.Priority)
+	}
+	for _, task := range scheduler.Expired() {
+		fmt.Printf("  Expired: %s\n", task.Name)
+	}
+
+	// h3 -- Running Median
+	// h4 -- Two-heap median checked against sorting after every insertion
+	fmt.Println("\n3. RUNNING MEDIAN")
+	fmt.Println("=================")
+
+	var median RunningMedian
+	var values []int
+	for _, v := range []int{5, 15, 1, 3, 8, 7, 9, 10, -2, 6} {
+		median.Add(v)
+		values = append(values, v)
+		fmt.Printf("  Add %2d -> median %4.1f (reference: %4.1f)\n", v, median.Median(), medianBySorting(values))
+	}
+
+	// h3 -- Top-N Accumulator
+	// h4 -- Bounded heap over a large stream versus sorting the whole stream
+	fmt.Println("\n4. TOP-N ACCUMULATOR")
+	fmt.Println("====================")
+
+	const streamSize, n = 1_000_000, 5
+	rng := rand.New(rand.NewSource(7))
+	greater := func(a, b int) bool { return a > b }
+	top := NewTopN(n, greater)
+	data := make([]int, streamSize)
+	for i := range data {
+		data[i] = rng.Intn(10_000_000)
+		top.Add(data[i])
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(data)))
+	fmt.Printf("  Stream of %d values, n = %d\n", streamSize, n)
+	fmt.Printf("  TopN result:   %v\n", top.Result())
+	fmt.Printf("  Sorted prefix: %v\n", data[:n])
+
+	words := NewTopN(3, func(a, b string) bool { return len(a) < len(b) })
+	for _, w := range []string{"heap", "a", "priority", "of", "queue", "to"} {
+		words.Add(w)
+	}
+	fmt.Printf("  Three shortest words: %v\n", words.Result())
+
+	// h3 -- Discrete-Event Simulation
+	// h4 -- Single-server queue: customers arrive, wait if busy, and depart after service
+	fmt.Println("\n5. DISCRETE-EVENT SIMULATION")
+	fmt.Println("============================")
+
+	arrivals := []float64{0, 1, 1.5, 6, 6.5}
+	const serviceTime = 2.0
+	var waiting []int
+	busy := false
+	lastTime, ordered := -1.0, true
+
+	sim := NewSimulator(func(sim *Simulator, e Event) {
+		if e.Time < lastTime {
+			ordered = false
+		}
+		lastTime = e.Time
+		fmt.Printf("  t=%4.1f %-9s customer %d\n", e.Time, e.Kind, e.ID)
+
+		switch e.Kind {
+		case "arrival":
+			if busy {
+				waiting = append(waiting, e.ID)
+				return
+			}
+			busy = true
+			sim.Schedule(Event{Time: sim.Now() + serviceTime, Kind: "departure", ID: e.ID})
+		case "departure":
+			if len(waiting) == 0 {
+				busy = false
+				return
+			}
+			next := waiting[0]
+			waiting = waiting[1:]
+			sim.Schedule(Event{Time: sim.Now() + serviceTime, Kind: "departure", ID: next})
+		}
+	})
+	for id, t := range arrivals {
+		sim.Schedule(Event{Time: t, Kind: "arrival", ID: id})
+	}
+	processed := sim.Run(100)
+	fmt.Printf("  Processed %d events, timestamps non-decreasing: %t\n", processed, ordered)
+
+	// h3 -- Smallest Range Covering k Lists
+	// h4 -- Lists of differing lengths, then random checks against brute force
+	fmt.Println("\n6. SMALLEST RANGE COVERING K LISTS")
+	fmt.Println("==================================")
+
+	lists := [][]int{
+		{4, 10, 15, 24, 26},
+		{0, 9, 12, 20},
+		{5, 18, 22, 30},
+	}
+	r := smallestRange(lists)
+	fmt.Printf("  %v -> %v (covers all: %t, brute force: %v)\n",
+		lists, r, rangeCoversAll(lists, r), bruteSmallestRange(lists))
+	single := [][]int{{1, 2, 3}, {7}, {3, 8, 9, 10, 11}}
+	r = smallestRange(single)
+	fmt.Printf("  %v -> %v (covers all: %t, brute force: %v)\n",
+		single, r, rangeCoversAll(single, r), bruteSmallestRange(single))
+
+	rangeRng := rand.New(rand.NewSource(9))
+	mismatches := 0
+	const rangeTrials = 300
+	for t := 0; t < rangeTrials; t++ {
+		random := make([][]int, 1+rangeRng.Intn(4))
+		for i := range random {
+			random[i] = make([]int, 1+rangeRng.Intn(6))
+			for j := range random[i] {
+				random[i][j] = rangeRng.Intn(40)
+			}
+			slices.Sort(random[i])
+		}
+		got := smallestRange(random)
+		if !rangeCoversAll(random, got) || got != bruteSmallestRange(random) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against brute force: %d\n", rangeTrials, mismatches)
+
+	// h3 -- Tagged K-Way Merge
+	// h4 -- Merging log streams by timestamp while remembering each entry's stream
+	fmt.Println("\n7. TAGGED K-WAY MERGE")
+	fmt.Println("=====================")
+
+	type logEntry struct {
+		At  int
+		Msg string
+	}
+	streams := [][]logEntry{
+		{{1, "api: start"}, {4, "api: request"}, {9, "api: stop"}},
+		{{2, "db: connect"}, {4, "db: query"}},
+		{},
+		{{0, "cron: tick"}, {5, "cron: tick"}, {10, "cron: tick"}},
+	}
+	byTime := func(a, b logEntry) bool { return a.At < b.At }
+	merged := mergeKTagged(streams, byTime)
+	for _, e := range merged {
+		fmt.Printf("  t=%2d stream %d  %s\n", e.Value.At, e.Source, e.Value.Msg)
+	}
+
+	tagRng := rand.New(rand.NewSource(11))
+	violations := 0
+	const tagTrials = 200
+	for t := 0; t < tagTrials; t++ {
+		sources := make([][]int, tagRng.Intn(6))
+		for i := range sources {
+			sources[i] = make([]int, tagRng.Intn(8))
+			for j := range sources[i] {
+				sources[i][j] = tagRng.Intn(20)
+			}
+			slices.Sort(sources[i])
+		}
+		out := mergeKTagged(sources, func(a, b int) bool { return a < b })
+
+		// Replaying the tags must reproduce every source exactly, in order
+		replay := make([][]int, len(sources))
+		for i, item := range out {
+			replay[item.Source] = append(replay[item.Source], item.Value)
+			if i > 0 && out[i-1].Value > item.Value {
+				violations++
+			}
+		}
+		for i := range sources {
+			if !slices.Equal(replay[i], sources[i]) {
+				violations++
+			}
+		}
+	}
+	fmt.Printf("  Random trials: %d, ordering or tag violations: %d\n", tagTrials, violations)
+
+	// h3 -- Retry Queue With Exponential Backoff
+	// h4 -- A fake clock steps forward; each failure doubles the wait up to the cap
+	fmt.Println("\n8. RETRY QUEUE WITH BACKOFF")
+	fmt.Println("===========================")
+
+	epoch := time.Unix(0, 0)
+	retryClock := epoch
+	policy := BackoffPolicy{Initial: time.Second, Multiplier: 2, Max: 5 * time.Second, MaxAttempts: 4}
+	retries := NewRetryQueue(policy, func() time.Time { return retryClock })
+	delays := make([]time.Duration, 5)
+	for n := range delays {
+		delays[n] = policy.Delay(n + 1)
+	}
+	fmt.Printf("  Delays for retries 1-5: %v\n", delays)
+
+	flaky := Task{Name: "sync inventory"}
+	steady := Task{Name: "send email"}
+	retries.Fail(flaky)
+	retries.Fail(steady)
+	for retryClock.Sub(epoch) <= 20*time.Second && retries.Waiting() > 0 {
+		for _, task := range retries.Ready(retryClock) {
+			if task.Name == steady.Name {
+				retries.Succeed(task) // Second attempt works
+				fmt.Printf("  t=%2ds %-14s succeeded\n", int(retryClock.Sub(epoch).Seconds()), task.Name)
+				continue
+			}
+			at, ok := retries.Fail(task)
+			if ok {
+				fmt.Printf("  t=%2ds %-14s failed again, retry at t=%ds\n",
+					int(retryClock.Sub(epoch).Seconds()), task.Name, int(at.Sub(epoch).Seconds()))
+			} else {
+				fmt.Printf("  t=%2ds %-14s failed again, dropped\n", int(retryClock.Sub(epoch).Seconds()), task.Name)
+			}
+		}
+		retryClock = retryClock.Add(time.Second)
+	}
+	fmt.Printf("  Waiting: %d, dropped: %d\n", retries.Waiting(), len(retries.Dropped()))
+
+	// No cap and unlimited attempts: the delay saturates instead of wrapping negative
+	uncapped := BackoffPolicy{Initial: time.Second, Multiplier: 2}
+	fmt.Printf("  Uncapped retry 30: %v, retry 100: %v, retry 10000: %v\n",
+		uncapped.Delay(30), uncapped.Delay(100), uncapped.Delay(10_000))
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable time source for RetryQueue.
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time              { return c.t }
+func (c *fakeClock) advance(d time.Duration)     { c.t = c.t.Add(d) }
+func (c *fakeClock) since(epoch time.Time) int64 { return int64(c.t.Sub(epoch) / time.Second) }
+
+func TestBackoffDelay(t *testing.T) {
+	policy := BackoffPolicy{Initial: time.Second, Multiplier: 2, Max: 5 * time.Second}
+	want := []time.Duration{1, 2, 4, 5, 5, 5} // Seconds: doubling, then held at the cap
+	for n, w := range want {
+		if got := policy.Delay(n + 1); got != w*time.Second {
+			t.Errorf("Delay(%d) = %v, want %v", n+1, got, w*time.Second)
+		}
+	}
+
+	uncapped := BackoffPolicy{Initial: time.Second, Multiplier: 2}
+	if got := uncapped.Delay(30); got != time.Duration(1<<29)*time.Second {
+		t.Errorf("uncapped Delay(30) = %v, want 2^29s", got)
+	}
+	prev := time.Duration(0)
+	for _, n := range []int{34, 35, 64, 100, 10_000} {
+		got := uncapped.Delay(n)
+		if got < prev || got <= 0 {
+			t.Errorf("uncapped Delay(%d) = %v, want non-decreasing and positive", n, got)
+		}
+		prev = got
+	}
+	if got := uncapped.Delay(100); got != math.MaxInt64 {
+		t.Errorf("uncapped Delay(100) = %v, want saturation at MaxInt64", got)
+	}
+	if got := (BackoffPolicy{Multiplier: 2}).Delay(10_000); got != 0 {
+		t.Errorf("zero Initial: Delay = %v, want 0", got)
+	}
+}
+
+func TestRetryQueueSchedule(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	clock := &fakeClock{t: epoch}
+	policy := BackoffPolicy{Initial: time.Second, Multiplier: 2, Max: 5 * time.Second, MaxAttempts: 5}
+	q := NewRetryQueue(policy, clock.now)
+	task := Task{Name: "sync"}
+
+	// Each retry is failed again the moment it surfaces
+	wantAt := []int64{1, 3, 7, 12, 17} // 1s, 2s, 4s, then capped at 5s
+	for i, want := range wantAt {
+		at, ok := q.Fail(task)
+		if !ok {
+			t.Fatalf("failure %d: task dropped early", i+1)
+		}
+		if got := int64(at.Sub(epoch) / time.Second); got != want {
+			t.Fatalf("failure %d: retry at t=%ds, want t=%ds", i+1, got, want)
+		}
+		if ready := q.Ready(at.Add(-time.Nanosecond)); len(ready) != 0 {
+			t.Fatalf("failure %d: %v ready before its deadline", i+1, ready)
+		}
+		clock.advance(at.Sub(clock.t))
+		if ready := q.Ready(clock.t); !slices.Equal(ready, []Task{task}) {
+			t.Fatalf("failure %d: Ready at t=%ds = %v, want the task", i+1, clock.since(epoch), ready)
+		}
+	}
+	if _, ok := q.Fail(task); ok {
+		t.Error("failure past MaxAttempts: want the task dropped")
+	}
+	if q.Waiting() != 0 || !slices.Equal(q.Dropped(), []Task{task}) {
+		t.Errorf("after drop: waiting %d, dropped %v", q.Waiting(), q.Dropped())
+	}
+}
+
+func TestRetryQueueSucceedResets(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	q := NewRetryQueue(BackoffPolicy{Initial: time.Second, Multiplier: 3}, clock.now)
+	task := Task{Name: "email"}
+	q.Fail(task)
+	q.Fail(task)
+	q.Succeed(task)
+	if at, _ := q.Fail(task); at.Sub(clock.t) != time.Second {
+		t.Errorf("after Succeed: next delay %v, want the initial 1s", at.Sub(clock.t))
+	}
+}
+
+func TestRetryQueueOrder(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	clock := &fakeClock{t: epoch}
+	q := NewRetryQueue(BackoffPolicy{Initial: 2 * time.Second, Multiplier: 2}, clock.now)
+	a, b, c := Task{Name: "a"}, Task{Name: "b"}, Task{Name: "c"}
+	q.Fail(a) // Due at t=2
+	q.Fail(b) // Due at t=2, after a
+	clock.advance(time.Second)
+	q.Fail(c) // Due at t=3
+
+	if ready := q.Ready(epoch.Add(time.Second)); len(ready) != 0 {
+		t.Errorf("t=1: Ready = %v, want nothing", ready)
+	}
+	if ready := q.Ready(epoch.Add(2 * time.Second)); !slices.Equal(ready, []Task{a, b}) {
+		t.Errorf("t=2: Ready = %v, want [a b] in failure order", ready)
+	}
+	if ready := q.Ready(epoch.Add(10 * time.Second)); !slices.Equal(ready, []Task{c}) {
+		t.Errorf("t=10: Ready = %v, want [c]", ready)
+	}
+	if q.Waiting() != 0 {
+		t.Errorf("Waiting = %d after draining", q.Waiting())
+	}
+}
+
+func TestRetryQueueUncappedSaturates(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	q := NewRetryQueue(BackoffPolicy{Initial: time.Second, Multiplier: 2}, clock.now)
+	task := Task{Name: "forever"}
+	var at time.Time
+	for i := 0; i < 100; i++ {
+		at, _ = q.Fail(task)
+		q.Ready(at) // Drain so only the latest attempt is queued
+	}
+	if !at.After(clock.t) {
+		t.Fatalf("retry 100 scheduled at %v, not after now %v: delay wrapped", at, clock.t)
+	}
+	if got := at.Sub(clock.t); got != math.MaxInt64 {
+		t.Errorf("retry 100 delay = %v, want the saturated maximum", got)
+	}
+	if ready := q.Ready(clock.t.Add(time.Hour)); len(ready) != 0 {
+		t.Errorf("saturated retry surfaced after an hour: %v", ready)
+	}
+}
+
+func TestKthLargestStream(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, k := range []int{1, 2, 3, 10} {
+		initial := make([]int, rng.Intn(5))
+		for i := range initial {
+			initial[i] = rng.Intn(20)
+		}
+		stream := NewKthLargestStream(k, initial)
+		seen := slices.Clone(initial)
+		for i := 0; i < 50; i++ {
+			v := rng.Intn(20) - 5 // Duplicates and negatives included
+			seen = append(seen, v)
+			if got, want := stream.Add(v), kthLargestBySorting(seen, k); got != want {
+				t.Fatalf("k=%d after %v: Add(%d) = %d, want %d", k, seen, v, got, want)
+			}
+		}
+	}
+}
+
+func TestKthLargestStreamRejectsBadK(t *testing.T) {
+	for _, k := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewKthLargestStream(%d, ...) did not panic", k)
+				}
+			}()
+			NewKthLargestStream(k, []int{1, 2})
+		}()
+	}
+}
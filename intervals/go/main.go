@@ -0,0 +1,398 @@
+// h1 -- Interval Algorithms Implementation in Go
+// h2 -- Scheduling and merging problems over [start, finish) ranges
+// h2 -- Combines sorting, binary search, heaps and dynamic programming
+
+package main
+
+import (
+	"container/heap"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+)
+
+// h3 -- Interval Type
+// h4 -- Half-open range [Start, Finish) carrying a weight
+// h6 -- Two intervals are compatible when one finishes at or before the other starts
+type Interval struct {
+	Start, Finish, Weight int
+}
+
+// h3 -- Upper Bound Function
+// h4 -- Binary search for the first index whose value is greater than target
+// h5 -- arr: Slice sorted in ascending order
+// h6 -- Returns: Index in [0, len(arr)]; equals the count of values <= target
+// h6 -- Time Complexity: O(log n)
+func upperBound(arr []int, target int) int {
+	low := 0
+	high := len(arr)
+	for low < high {
+		mid := low + (high-low)/2
+		if arr[mid] <= target {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+// h3 -- Weighted Interval Scheduling Function
+// h4 -- DP over intervals sorted by finish time
+// h5 -- intervals: Candidate intervals (left unmodified)
+// h6 -- Returns: Maximum total weight of a compatible subset and the chosen indices (ascending)
+// h6 -- Time Complexity: O(n log n), Space Complexity: O(n)
+// h6 -- Note: best[j] is the optimum over the first j intervals by finish time; interval j
+// h6 --       either is skipped (best[j]) or joins the optimum of those finishing by its start
+func weightedIntervalScheduling(intervals []Interval) (int, []int) {
+	n := len(intervals)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool {
+		return intervals[order[a]].Finish < intervals[order[b]].Finish
+	})
+
+	finishes := make([]int, n)
+	for j, idx := range order {
+		finishes[j] = intervals[idx].Finish
+	}
+
+	// compatible[j]: how many sorted intervals finish by the start of interval j
+	compatible := make([]int, n)
+	best := make([]int, n+1)
+	for j, idx := range order {
+		compatible[j] = upperBound(finishes, intervals[idx].Start)
+		take := intervals[idx].Weight + best[compatible[j]]
+		best[j+1] = max(best[j], take)
+	}
+
+	// Walk back through the table to recover which intervals were taken
+	var chosen []int
+	for j := n; j > 0; {
+		idx := order[j-1]
+		if best[j] != best[j-1] { // Taking interval j-1 improved the optimum
+			chosen = append(chosen, idx)
+			j = compatible[j-1]
+		} else {
+			j--
+		}
+	}
+	slices.Sort(chosen)
+	return best[n], chosen
+}
+
+// h3 -- Brute-Force Scheduling Function
+// h4 -- Tries every subset and keeps the heaviest pairwise-compatible one
+// h6 -- Time Complexity: O(2^n * n²) - reference for small inputs only
+func bruteForceScheduling(intervals []Interval) int {
+	n := len(intervals)
+	best := 0
+	for mask := 0; mask < 1<<n; mask++ {
+		weight, ok := 0, true
+		for i := 0; i < n && ok; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			weight += intervals[i].Weight
+			for j := i + 1; j < n; j++ {
+				if mask&(1<<j) != 0 &&
+					intervals[i].Start < intervals[j].Finish && intervals[j].Start < intervals[i].Finish {
+					ok = false // Overlap
+					break
+				}
+			}
+		}
+		if ok {
+			best = max(best, weight)
+		}
+	}
+	return best
+}
+
+// h3 -- Interval List Node Type
+// h4 -- Singly linked list node carrying an Interval payload
+type IntervalNode struct {
+	Interval
+	Next *IntervalNode
+}
+
+// h3 -- List Conversion Helpers
+// h4 -- Build a list from a slice and flatten it back for comparisons
+func intervalListFromSlice(intervals []Interval) *IntervalNode {
+	var head *IntervalNode
+	for i := len(intervals) - 1; i >= 0; i-- {
+		head = &IntervalNode{Interval: intervals[i], Next: head}
+	}
+	return head
+}
+
+func intervalListToSlice(head *IntervalNode) []Interval {
+	var out []Interval
+	for node := head; node != nil; node = node.Next {
+		out = append(out, node.Interval)
+	}
+	return out
+}
+
+// h3 -- Merge Interval List Function
+// h4 -- Collapses overlapping or touching neighbours by relinking, without allocating
+// h5 -- head: List sorted by Start
+// h6 -- Returns: The same head; absorbed nodes are unlinked and their Weight is added to the survivor
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+func mergeIntervalList(head *IntervalNode) *IntervalNode {
+	for node := head; node != nil; node = node.Next {
+		for node.Next != nil && node.Next.Start <= node.Finish {
+			absorbed := node.Next
+			node.Finish = max(node.Finish, absorbed.Finish)
+			node.Weight += absorbed.Weight
+			node.Next = absorbed.Next
+			absorbed.Next = nil
+		}
+	}
+	return head
+}
+
+// h3 -- Interval Set Type
+// h4 -- Minimal sorted set of disjoint half-open ranges [lo, hi)
+// h6 -- Touching ranges are merged, so consecutive spans always have a gap between them
+type IntervalSet struct {
+	spans []Interval // Weight is unused
+	total int
+}
+
+// h3 -- Add Function
+// h4 -- Binary searches the affected run of spans and replaces it with one merged span
+// h5 -- lo, hi: Range to add; empty ranges (lo >= hi) are ignored
+// h6 -- Time Complexity: O(log n) search plus O(n) for the slice splice
+func (s *IntervalSet) Add(lo, hi int) {
+	if lo >= hi {
+		return
+	}
+	// [first, last) are the spans that overlap or touch [lo, hi)
+	first := sort.Search(len(s.spans), func(i int) bool { return s.spans[i].Finish >= lo })
+	last := sort.Search(len(s.spans), func(i int) bool { return s.spans[i].Start > hi })
+
+	merged := Interval{Start: lo, Finish: hi}
+	for _, span := range s.spans[first:last] {
+		merged.Start = min(merged.Start, span.Start)
+		merged.Finish = max(merged.Finish, span.Finish)
+		s.total -= span.Finish - span.Start
+	}
+	s.total += merged.Finish - merged.Start
+	s.spans = slices.Replace(s.spans, first, last, merged)
+}
+
+// h3 -- Covers Function
+// h6 -- Returns: true if point lies inside some span
+// h6 -- Time Complexity: O(log n)
+func (s *IntervalSet) Covers(point int) bool {
+	i := sort.Search(len(s.spans), func(i int) bool { return s.spans[i].Finish > point })
+	return i < len(s.spans) && s.spans[i].Start <= point
+}
+
+// h3 -- Total Length Function
+// h6 -- Returns: Number of integer points covered, maintained incrementally in O(1)
+func (s *IntervalSet) TotalLength() int {
+	return s.total
+}
+
+// h3 -- End Time Min-Heap
+// h4 -- container/heap adapter holding the finish times of rooms in use
+type endTimeHeap []int
+
+func (h endTimeHeap) Len() int           { return len(h) }
+func (h endTimeHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h endTimeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *endTimeHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *endTimeHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// h3 -- Minimum Meeting Rooms Function
+// h4 -- Fewest rooms so that no two overlapping meetings share one
+// h5 -- intervals: Meetings as half-open [start, end) pairs
+// h6 -- Meetings are taken by start time; the heap root is the room that frees up first,
+// h6 -- reused when it ends at or before the new start, otherwise a room is added
+// h6 -- Returns: Peak heap size, i.e. the most meetings in progress at once
+// h6 -- Time Complexity: O(n log n)
+func minMeetingRooms(intervals [][2]int) int {
+	meetings := slices.Clone(intervals)
+	slices.SortFunc(meetings, func(a, b [2]int) int { return a[0] - b[0] })
+
+	var rooms endTimeHeap
+	for _, m := range meetings {
+		if rooms.Len() > 0 && rooms[0] <= m[0] {
+			heap.Pop(&rooms) // Back-to-back meetings can share a room
+		}
+		heap.Push(&rooms, m[1])
+	}
+	return rooms.Len()
+}
+
+// h3 -- Brute-Force Meeting Rooms Function
+// h4 -- Reference implementation: the most meetings covering any single start time
+// h6 -- Time Complexity: O(n²)
+func bruteMeetingRooms(intervals [][2]int) int {
+	best := 0
+	for _, at := range intervals {
+		overlapping := 0
+		for _, m := range intervals {
+			if m[0] <= at[0] && at[0] < m[1] {
+				overlapping++
+			}
+		}
+		best = max(best, overlapping)
+	}
+	return best
+}
+
+func main() {
+	fmt.Println("=== INTERVAL ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Weighted Interval Scheduling
+	// h4 -- DP result and selection, then random cross-checks against brute force
+	fmt.Println("1. WEIGHTED INTERVAL SCHEDULING")
+	fmt.Println("===============================")
+
+	intervals := []Interval{
+		{Start: 1, Finish: 4, Weight: 5},
+		{Start: 3, Finish: 5, Weight: 1},
+		{Start: 0, Finish: 6, Weight: 8},
+		{Start: 4, Finish: 7, Weight: 4},
+		{Start: 3, Finish: 9, Weight: 6},
+		{Start: 5, Finish: 9, Weight: 3},
+		{Start: 6, Finish: 10, Weight: 2},
+		{Start: 8, Finish: 11, Weight: 4},
+	}
+	weight, chosen := weightedIntervalScheduling(intervals)
+	fmt.Printf("  Max weight: %d (brute force: %d)\n", weight, bruteForceScheduling(intervals))
+	for _, idx := range chosen {
+		fmt.Printf("    chose #%d %+v\n", idx, intervals[idx])
+	}
+
+	rng := rand.New(rand.NewSource(3))
+	mismatches := 0
+	const trials = 300
+	for t := 0; t < trials; t++ {
+		random := make([]Interval, rng.Intn(11))
+		for i := range random {
+			start := rng.Intn(20)
+			random[i] = Interval{Start: start, Finish: start + 1 + rng.Intn(6), Weight: rng.Intn(10)}
+		}
+		got, picks := weightedIntervalScheduling(random)
+		sum := 0
+		for _, idx := range picks {
+			sum += random[idx].Weight
+		}
+		if got != bruteForceScheduling(random) || sum != got {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against brute force: %d\n", trials, mismatches)
+
+	// h3 -- Merging a Linked List of Intervals
+	// h4 -- Overlapping and touching neighbours collapse, disjoint ones survive
+	fmt.Println("\n2. MERGE INTERVAL LIST")
+	fmt.Println("======================")
+
+	lists := []struct {
+		name      string
+		intervals []Interval
+	}{
+		{"overlapping", []Interval{{1, 3, 1}, {2, 6, 1}, {8, 10, 1}, {9, 12, 1}}},
+		{"touching", []Interval{{1, 4, 1}, {4, 5, 1}, {5, 7, 1}}},
+		{"nested", []Interval{{1, 10, 1}, {2, 3, 1}, {4, 5, 1}, {11, 12, 1}}},
+		{"disjoint", []Interval{{1, 2, 1}, {3, 4, 1}, {5, 6, 1}}},
+		{"empty", nil},
+	}
+	for _, l := range lists {
+		merged := intervalListToSlice(mergeIntervalList(intervalListFromSlice(l.intervals)))
+		fmt.Printf("  %-11s %v -> %v\n", l.name, l.intervals, merged)
+	}
+
+	// h3 -- Interval Set
+	// h4 -- Incremental additions and coverage queries checked against a boolean grid
+	fmt.Println("\n3. INTERVAL SET")
+	fmt.Println("===============")
+
+	var set IntervalSet
+	for _, r := range [][2]int{{5, 8}, {1, 3}, {10, 12}, {2, 6}, {12, 14}, {20, 25}, {0, 30}} {
+		set.Add(r[0], r[1])
+		spans := make([][2]int, len(set.spans))
+		for i, span := range set.spans {
+			spans[i] = [2]int{span.Start, span.Finish}
+		}
+		fmt.Printf("  Add [%d, %d): %v total %d\n", r[0], r[1], spans, set.TotalLength())
+		if r[0] == 20 {
+			fmt.Printf("    Covers 0: %t, 7: %t, 8: %t, 9: %t, 13: %t, 14: %t\n",
+				set.Covers(0), set.Covers(7), set.Covers(8), set.Covers(9), set.Covers(13), set.Covers(14))
+		}
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		var random IntervalSet
+		covered := make([]bool, 40)
+		for k := rng.Intn(8); k > 0; k-- {
+			lo := rng.Intn(35)
+			hi := lo + rng.Intn(6)
+			random.Add(lo, hi)
+			for p := lo; p < hi; p++ {
+				covered[p] = true
+			}
+		}
+		count := 0
+		for p, c := range covered {
+			if c {
+				count++
+			}
+			if random.Covers(p) != c {
+				mismatches++
+			}
+		}
+		if count != random.TotalLength() {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against a boolean grid: %d\n", trials, mismatches)
+
+	// h3 -- Meeting Rooms
+	// h4 -- Rooms needed for overlapping, back-to-back and mixed schedules
+	fmt.Println("\n4. MEETING ROOMS")
+	fmt.Println("================")
+
+	schedules := []struct {
+		name     string
+		meetings [][2]int
+	}{
+		{"fully overlapping", [][2]int{{0, 10}, {1, 9}, {2, 8}, {3, 7}}},
+		{"back-to-back", [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}}},
+		{"mixed", [][2]int{{0, 30}, {5, 10}, {15, 20}, {10, 15}, {25, 35}}},
+		{"empty", nil},
+	}
+	for _, sc := range schedules {
+		fmt.Printf("  %-17s %v: %d rooms (brute force: %d)\n",
+			sc.name, sc.meetings, minMeetingRooms(sc.meetings), bruteMeetingRooms(sc.meetings))
+	}
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		meetings := make([][2]int, rng.Intn(12))
+		for i := range meetings {
+			start := rng.Intn(20)
+			meetings[i] = [2]int{start, start + 1 + rng.Intn(6)}
+		}
+		if minMeetingRooms(meetings) != bruteMeetingRooms(meetings) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against brute force: %d\n", trials, mismatches)
+}
@@ -0,0 +1,180 @@
+package main
+
+import "fmt"
+
+// List wraps Node with the bookkeeping needed to mutate it safely. The same
+// type covers the four configurations createList builds: doubly controls
+// whether prev links are maintained, circular whether the tail links back to
+// the head (and, when doubly, the head's prev to the tail).
+type List[T comparable] struct {
+	head     *Node[T]
+	size     int
+	doubly   bool
+	circular bool
+}
+
+func NewList[T comparable](doubly, circular bool) *List[T] {
+	return &List[T]{doubly: doubly, circular: circular}
+}
+
+func (l *List[T]) Len() int { return l.size }
+
+// nodeAt walks i steps from the head; i must be in [0, size).
+func (l *List[T]) nodeAt(i int) *Node[T] {
+	node := l.head
+	for ; i > 0; i-- {
+		node = node.next
+	}
+	return node
+}
+
+func (l *List[T]) tail() *Node[T] {
+	if l.head == nil {
+		return nil
+	}
+	if l.circular && l.doubly {
+		return l.head.prev
+	}
+	return l.nodeAt(l.size - 1)
+}
+
+// InsertAt places value so that it ends up at index pos. Valid positions are
+// 0 (new head) through Len() (new tail).
+func (l *List[T]) InsertAt(pos int, value T) error {
+	if pos < 0 || pos > l.size {
+		return fmt.Errorf("insert position %d out of range [0, %d]", pos, l.size)
+	}
+	node := &Node[T]{data: value}
+
+	// prev is the node that will precede the new one; at position 0 in a
+	// circular list that is the tail, otherwise there is none
+	var prev *Node[T]
+	if pos > 0 {
+		prev = l.nodeAt(pos - 1)
+	} else if l.circular {
+		prev = l.tail()
+	}
+	next := l.head
+	if prev != nil {
+		next = prev.next
+	}
+
+	if l.size == 0 && l.circular {
+		prev, next = node, node // A lone circular node points at itself
+	}
+	node.next = next
+	if prev != nil {
+		prev.next = node
+	}
+	if l.doubly {
+		node.prev = prev
+		if next != nil {
+			next.prev = node
+		}
+	}
+	if pos == 0 {
+		l.head = node
+	}
+	l.size++
+	return nil
+}
+
+// DeleteValue unlinks the first node holding value. It reports whether a node
+// was removed.
+func (l *List[T]) DeleteValue(value T) bool {
+	var prev *Node[T]
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		if cur.data == value {
+			l.unlink(prev, cur)
+			return true
+		}
+		prev, cur = cur, cur.next
+	}
+	return false
+}
+
+func (l *List[T]) unlink(prev, cur *Node[T]) {
+	if l.size == 1 {
+		l.head = nil
+	} else {
+		if cur == l.head && l.circular {
+			prev = l.tail()
+		}
+		if prev != nil {
+			prev.next = cur.next
+		}
+		if l.doubly && cur.next != nil {
+			cur.next.prev = prev
+		}
+		if cur == l.head {
+			l.head = cur.next
+		}
+	}
+	cur.next, cur.prev = nil, nil
+	l.size--
+}
+
+// Reverse flips every link in place in O(n) time without allocating. For
+// circular lists the old tail becomes the head and the ring stays closed.
+func (l *List[T]) Reverse() {
+	if l.size < 2 {
+		return
+	}
+	var prev *Node[T]
+	if l.circular {
+		prev = l.tail()
+	}
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		next := cur.next
+		cur.next = prev
+		if l.doubly {
+			cur.prev = next
+		}
+		prev, cur = cur, next
+	}
+	l.head = prev
+}
+
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for i, node := 0, l.head; i < l.size; i, node = i+1, node.next {
+		values = append(values, node.data)
+	}
+	return values
+}
+
+// checkLinks verifies the structural invariants of the configuration: the
+// forward walk has exactly size nodes and ends correctly, and every prev link
+// mirrors a next link (or is nil for singly linked lists).
+func (l *List[T]) checkLinks() error {
+	if l.size == 0 {
+		if l.head != nil {
+			return fmt.Errorf("empty list has a head")
+		}
+		return nil
+	}
+	node := l.head
+	for i := 0; i < l.size; i++ {
+		next := node.next
+		switch {
+		case next == nil && (l.circular || i < l.size-1):
+			return fmt.Errorf("next link of node %d is nil", i)
+		case next != nil && !l.circular && i == l.size-1:
+			return fmt.Errorf("tail links past the end")
+		case l.doubly && next != nil && next.prev != node:
+			return fmt.Errorf("prev link of node %d does not mirror next", i+1)
+		case !l.doubly && node.prev != nil:
+			return fmt.Errorf("singly linked node %d has a prev link", i)
+		}
+		node = next
+	}
+	if l.circular && node != l.head {
+		return fmt.Errorf("ring does not close at the head")
+	}
+	if l.doubly && !l.circular && l.head.prev != nil {
+		return fmt.Errorf("head has a prev link")
+	}
+	return nil
+}
@@ -1,20 +1,35 @@
 package main
 
 import (
+	"encoding/csv"
+	"flag"
 	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strconv"
 	"time"
 )
 
-type Node struct {
-	data int
-	next *Node
-	prev *Node
+type Node[T any] struct {
+	data T
+	next *Node[T]
+	prev *Node[T]
 }
 
-func createList(n int, doubly, circular bool) *Node {
-	var head, tail *Node
-	for i := 0; i < n; i++ {
-		node := &Node{data: i}
+// createList builds the integer list 0..n-1 used by the benchmarks.
+func createList(n int, doubly, circular bool) *Node[int] {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	return listFromSlice(values, doubly, circular)
+}
+
+func listFromSlice[T any](values []T, doubly, circular bool) *Node[T] {
+	var head, tail *Node[T]
+	for _, v := range values {
+		node := &Node[T]{data: v}
 		if head == nil {
 			head, tail = node, node
 		} else {
@@ -34,11 +49,16 @@ func createList(n int, doubly, circular bool) *Node {
 	return head
 }
 
-func search(head *Node, target int, circular bool, n int) bool {
+func search[T comparable](head *Node[T], target T, circular bool, n int) bool {
+	return searchFunc(head, target, func(a, b T) bool { return a == b }, circular, n)
+}
+
+// searchFunc is search for payloads without ==, matching through equal.
+func searchFunc[T any](head *Node[T], target T, equal func(a, b T) bool, circular bool, n int) bool {
 	curr := head
 	count := 0
 	for curr != nil && (!circular || count < n) {
-		if curr.data == target {
+		if equal(curr.data, target) {
 			return true
 		}
 		curr = curr.next
@@ -47,15 +67,300 @@ func search(head *Node, target int, circular bool, n int) bool {
 	return false
 }
 
-func benchmark(head *Node, target int, circular bool, n int) float64 {
+// DetectCycle follows next links with Floyd's tortoise and hare. When the
+// list loops back on itself it returns the first node of the cycle and true;
+// for a nil-terminated list it returns nil and false. The cycle may start
+// anywhere, including a single node whose next is itself.
+func DetectCycle[T any](head *Node[T]) (*Node[T], bool) {
+	slow, fast := head, head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			// The distance from head to the cycle start equals the distance
+			// from the meeting point to it, walking forwards
+			slow = head
+			for slow != fast {
+				slow, fast = slow.next, fast.next
+			}
+			return slow, true
+		}
+	}
+	return nil, false
+}
+
+// Length counts distinct nodes reachable from head, so it terminates on
+// circular lists without the caller tracking n.
+func Length[T any](head *Node[T]) int {
+	start, cyclic := DetectCycle(head)
+	if !cyclic {
+		n := 0
+		for node := head; node != nil; node = node.next {
+			n++
+		}
+		return n
+	}
+	n := 0
+	for node := head; node != start; node = node.next {
+		n++ // Nodes before the cycle
+	}
+	n++
+	for node := start.next; node != start; node = node.next {
+		n++
+	}
+	return n
+}
+
+// RemoveNthFromEnd unlinks the nth node counting back from the tail (n == 1
+// is the tail) of a nil-terminated list in a single pass: a lead pointer gets
+// n steps ahead, then lead and trail advance together until lead runs off the
+// end, leaving trail just before the target. A hare moving two nodes per lead
+// step rides along and meets lead only if the list is circular, so a cycle is
+// caught without a separate traversal. It returns the possibly-new head, or an
+// error with the list untouched when n is not in [1, length] or the list is
+// circular (it has no end to count from). In doubly linked lists the prev
+// link of the following node is repaired.
+func RemoveNthFromEnd[T any](head *Node[T], n int) (*Node[T], error) {
+	if n <= 0 {
+		return head, fmt.Errorf("n must be positive, got %d", n)
+	}
+	lead, hare := head, head
+	// advance moves lead one node and the hare two, reporting whether they met
+	advance := func() bool {
+		lead = lead.next
+		if hare == nil || hare.next == nil {
+			hare = nil // The list ends, so it has no cycle
+			return false
+		}
+		hare = hare.next.next
+		return lead != nil && hare == lead
+	}
+	errCircular := fmt.Errorf("list is circular, so it has no end to count from")
+
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return head, fmt.Errorf("n %d exceeds list length %d", n, i)
+		}
+		if advance() {
+			return head, errCircular
+		}
+	}
+
+	// A sentinel before head means removing the head needs no special case
+	sentinel := &Node[T]{next: head}
+	trail := sentinel
+	for lead != nil {
+		if advance() {
+			return head, errCircular
+		}
+		trail = trail.next
+	}
+
+	removed := trail.next
+	trail.next = removed.next
+	if next := removed.next; next != nil && next.prev == removed {
+		next.prev = removed.prev // nil when the head was removed
+	}
+	removed.next, removed.prev = nil, nil
+	return sentinel.next, nil
+}
+
+// listValues collects the payloads of a nil-terminated list.
+func listValues[T any](head *Node[T]) []T {
+	var values []T
+	for node := head; node != nil; node = node.next {
+		values = append(values, node.data)
+	}
+	return values
+}
+
+// listShape splits a list into the nodes before any cycle (tail) and the
+// nodes on it (loop). A nil-terminated list is all tail, a circular list
+// all loop, and a rho-shaped list, whose tail links back into the middle,
+// has both.
+func listShape[T any](head *Node[T]) (tail, loop int) {
+	start, cyclic := DetectCycle(head)
+	for node := head; node != start; node = node.next {
+		tail++ // Stops at nil when start is nil, i.e. the list is acyclic
+	}
+	if cyclic {
+		loop = 1
+		for node := start.next; node != start; node = node.next {
+			loop++
+		}
+	}
+	return tail, loop
+}
+
+// listEqual reports whether a and b hold the same values in the same shape:
+// equal tail and cycle lengths, and prev links present on the same nodes (so
+// a singly linked list never equals a doubly linked one). It terminates on
+// every shape DetectCycle accepts, including rho-shaped lists.
+func listEqual[T comparable](a, b *Node[T]) bool {
+	tailA, loopA := listShape(a)
+	tailB, loopB := listShape(b)
+	if tailA != tailB || loopA != loopB {
+		return false
+	}
+	currA, currB := a, b
+	for i := 0; i < tailA+loopA; i++ {
+		if currA.data != currB.data || (currA.prev == nil) != (currB.prev == nil) {
+			return false
+		}
+		currA, currB = currA.next, currB.next
+	}
+	return true
+}
+
+func josephus(n, k int) int {
+	if n <= 0 || k <= 0 {
+		return -1
+	}
+	head := createList(n, false, true)
+
+	// Start from the tail so prev.next is always the next person to count
+	prev := head
+	for i := 0; i < n-1; i++ {
+		prev = prev.next
+	}
+	for remaining := n; remaining > 1; remaining-- {
+		for i := 0; i < k-1; i++ {
+			prev = prev.next
+		}
+		prev.next = prev.next.next
+	}
+	return prev.data
+}
+
+func josephusMath(n, k int) int {
+	if n <= 0 || k <= 0 {
+		return -1
+	}
+	// J(1) = 0, J(i) = (J(i-1) + k) mod i
+	survivor := 0
+	for i := 2; i <= n; i++ {
+		survivor = (survivor + k) % i
+	}
+	return survivor
+}
+
+func benchmark(head *Node[int], target int, circular bool, n int) float64 {
 	start := time.Now()
 	search(head, target, circular, n)
 	return time.Since(start).Seconds()
 }
 
+func sumList(head *Node[int]) int {
+	total := 0
+	for node := head; node != nil; node = node.next {
+		total += node.data
+	}
+	return total
+}
+
+func sumSlice(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// shuffledList links the values 0..n-1 in order, but through nodes whose
+// allocation order is randomized, so neighbours in the list are scattered in
+// memory the way they are in a long-lived list that has seen many inserts.
+func shuffledList(n int, rng *rand.Rand) *Node[int] {
+	nodes := make([]*Node[int], n)
+	for _, i := range rng.Perm(n) {
+		nodes[i] = &Node[int]{data: i}
+	}
+	for i := 0; i+1 < n; i++ {
+		nodes[i].next = nodes[i+1]
+	}
+	if n == 0 {
+		return nil
+	}
+	return nodes[0]
+}
+
+// timeSum reports the best of several runs to filter out scheduler noise.
+func timeSum(rounds int, sum func() int) (time.Duration, int) {
+	best := time.Duration(-1)
+	result := 0
+	for r := 0; r < rounds; r++ {
+		start := time.Now()
+		result = sum()
+		if elapsed := time.Since(start); best < 0 || elapsed < best {
+			best = elapsed
+		}
+	}
+	return best, result
+}
+
+// traversalBenchmark sums the same n values held in a slice, in a freshly
+// allocated list and in a list with scattered nodes. The slice is contiguous
+// and prefetch-friendly; every list step is a dependent pointer load, so
+// expect the list to be several times slower even when its nodes happen to
+// be allocated back to back, and often 10x or more once they are scattered.
+func traversalBenchmark(n, rounds int) {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i
+	}
+	sequential := createList(n, false, false)
+	scattered := shuffledList(n, rand.New(rand.NewSource(1)))
+
+	sliceTime, sliceSum := timeSum(rounds, func() int { return sumSlice(values) })
+	listTime, listSum := timeSum(rounds, func() int { return sumList(sequential) })
+	scatterTime, scatterSum := timeSum(rounds, func() int { return sumList(scattered) })
+
+	fmt.Printf("Slice:            %v\n", sliceTime)
+	fmt.Printf("List (in order):  %v (%.1fx slice)\n", listTime, listTime.Seconds()/sliceTime.Seconds())
+	fmt.Printf("List (scattered): %v (%.1fx slice)\n", scatterTime, scatterTime.Seconds()/sliceTime.Seconds())
+	fmt.Printf("Sums agree: %t\n", sliceSum == listSum && listSum == scatterSum)
+}
+
+type benchmarkResult struct {
+	listType string
+	position string
+	seconds  float64
+}
+
+func writeCSV(w io.Writer, results []benchmarkResult) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"list_type", "position", "seconds"}); err != nil {
+		return err
+	}
+	for _, r := range results {
+		row := []string{r.listType, r.position, strconv.FormatFloat(r.seconds, 'f', -1, 64)}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeCSVFile(path string, results []benchmarkResult) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeCSV(f, results); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 func main() {
-	N := 1_000_000
-	lists := []*Node{
+	csvPath := flag.String("csv", "", "write benchmark results to this CSV file")
+	size := flag.Int("n", 1_000_000, "number of elements in the benchmarked lists and slice")
+	rounds := flag.Int("rounds", 5, "runs per traversal timing (the fastest is reported)")
+	flag.Parse()
+
+	N := *size
+	lists := []*Node[int]{
 		createList(N, false, false),
 		createList(N, true, false),
 		createList(N, false, true),
@@ -65,10 +370,119 @@ func main() {
 		"Singly", "Doubly", "Circular Singly", "Circular Doubly",
 	}
 
+	positions := []struct {
+		name   string
+		target int
+	}{
+		{"First", 0}, {"Middle", N / 2}, {"Last", N - 1},
+	}
+
+	var results []benchmarkResult
 	for i, head := range lists {
 		fmt.Printf("\n%s Linked List:\n", names[i])
-		fmt.Printf("First: %f sec\n", benchmark(head, 0, i >= 2, N))
-		fmt.Printf("Middle: %f sec\n", benchmark(head, N/2, i >= 2, N))
-		fmt.Printf("Last: %f sec\n", benchmark(head, N-1, i >= 2, N))
+		for _, pos := range positions {
+			seconds := benchmark(head, pos.target, i >= 2, N)
+			fmt.Printf("%s: %f sec\n", pos.name, seconds)
+			results = append(results, benchmarkResult{names[i], pos.name, seconds})
+		}
+	}
+
+	if *csvPath != "" {
+		if err := writeCSVFile(*csvPath, results); err != nil {
+			fmt.Fprintf(os.Stderr, "writing CSV: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("\nWrote %d results to %s\n", len(results), *csvPath)
+	}
+
+	fmt.Printf("\nSum traversal, %d elements (best of %d):\n", N, *rounds)
+	traversalBenchmark(N, *rounds)
+
+	fmt.Println("\nJosephus (0-based survivor):")
+	for _, nk := range [][2]int{{7, 3}, {10, 1}, {41, 3}, {100, 7}} {
+		fmt.Printf("n=%d k=%d: simulated %d, recurrence %d\n",
+			nk[0], nk[1], josephus(nk[0], nk[1]), josephusMath(nk[0], nk[1]))
+	}
+
+	fmt.Println("\nPolynomials:")
+	p := NewPolynomial(map[int]float64{2: 3, 1: 2, 0: 1})
+	q := NewPolynomial(map[int]float64{3: 1, 1: -2, 0: 4})
+	fmt.Printf("p = %v\nq = %v\n", p, q)
+	fmt.Printf("p + q = %v\n", p.Add(q))
+	fmt.Printf("p * q = %v\n", p.Multiply(q))
+	fmt.Printf("p(2) = %g, q(2) = %g, (p*q)(2) = %g\n", p.Evaluate(2), q.Evaluate(2), p.Multiply(q).Evaluate(2))
+	r := NewPolynomial(map[int]float64{1: 1, 0: 1})
+	s := NewPolynomial(map[int]float64{1: 1, 0: -1})
+	fmt.Printf("(%v) * (%v) = %v\n", r, s, r.Multiply(s))
+	neg := NewPolynomial(map[int]float64{2: -1, 1: 3, -1: 2})
+	fmt.Printf("%v at x=2: %g\n", neg, neg.Evaluate(2))
+
+	fmt.Println("\nEquality:")
+	fmt.Printf("Singly vs rebuilt singly: %t\n", listEqual(createList(5, false, false), createList(5, false, false)))
+	fmt.Printf("Singly vs doubly: %t\n", listEqual(createList(5, false, false), createList(5, true, false)))
+	fmt.Printf("Circular vs longer circular: %t\n", listEqual(createList(5, false, true), createList(6, false, true)))
+
+	fmt.Println("\nCycle detection:")
+	for i, name := range names {
+		head := createList(5, i%2 == 1, i >= 2)
+		start, cyclic := DetectCycle(head)
+		fmt.Printf("%s: cyclic %t, starts at head %t, length %d\n", name, cyclic, start == head, Length(head))
+	}
+
+	fmt.Println("\nSorting:")
+	sortRng := rand.New(rand.NewSource(2))
+	for _, sorter := range []struct {
+		name string
+		sort func(*Node[int]) *Node[int]
+	}{
+		{"top-down", sortListTopDown[int]},
+		{"bottom-up", sortListBottomUp[int]},
+	} {
+		head := listFromSlice(sortRng.Perm(N), false, false)
+		start := time.Now()
+		head = sorter.sort(head)
+		elapsed := time.Since(start)
+		sorted := true
+		for node := head; node != nil && node.next != nil; node = node.next {
+			sorted = sorted && node.data <= node.next.data
+		}
+		fmt.Printf("%s merge sort of %d nodes: %v (sorted: %t, length %d)\n",
+			sorter.name, N, elapsed, sorted, Length(head))
+	}
+
+	evens := listFromSlice([]int{0, 2, 4, 6, 8}, false, false)
+	odds := listFromSlice([]int{1, 3, 5, 5}, false, false)
+	merged := MergeSorted(evens, odds)
+	fmt.Printf("MergeSorted([0 2 4 6 8], [1 3 5 5]): %v\n", listValues(merged))
+
+	fmt.Println("\nList mutations:")
+	for i, name := range names {
+		l := NewList[int](i%2 == 1, i >= 2)
+		for _, v := range []int{20, 40} {
+			l.InsertAt(l.Len(), v)
+		}
+		l.InsertAt(0, 10)       // Head
+		l.InsertAt(2, 30)       // Middle
+		l.InsertAt(4, 50)       // Tail
+		err := l.InsertAt(9, 0) // Out of range
+		fmt.Printf("%s: %v (insert at 9: %v)\n", name, l.Values(), err)
+
+		l.Reverse()
+		fmt.Printf("  reversed: %v, links: %v\n", l.Values(), l.checkLinks())
+		deleted := []bool{l.DeleteValue(50), l.DeleteValue(30), l.DeleteValue(10), l.DeleteValue(99)}
+		fmt.Printf("  delete 50, 30, 10, 99: %v -> %v, links: %v\n", deleted, l.Values(), l.checkLinks())
+		l.DeleteValue(40)
+		l.DeleteValue(20)
+		fmt.Printf("  emptied: %v, links: %v\n", l.Values(), l.checkLinks())
+	}
+
+	fmt.Println("\nRemove Nth from end:")
+	for _, n := range []int{1, 3, 5, 6, 0} {
+		head, err := RemoveNthFromEnd(listFromSlice([]int{1, 2, 3, 4, 5}, true, false), n)
+		if err != nil {
+			fmt.Printf("n=%d: error: %v\n", n, err)
+			continue
+		}
+		fmt.Printf("n=%d: [1 2 3 4 5] -> %v\n", n, listValues(head))
 	}
 }
@@ -0,0 +1,540 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+var listShapes = []struct {
+	name             string
+	doubly, circular bool
+}{
+	{"singly", false, false},
+	{"doubly", true, false},
+	{"circular-singly", false, true},
+	{"circular-doubly", true, true},
+}
+
+func TestSearch(t *testing.T) {
+	const n = 10
+	for _, shape := range listShapes {
+		head := createList(n, shape.doubly, shape.circular)
+		tests := []struct {
+			name   string
+			target int
+			want   bool
+		}{
+			{"first", 0, true},
+			{"middle", n / 2, true},
+			{"last", n - 1, true},
+			{"not found", n, false},
+		}
+		for _, tt := range tests {
+			t.Run(shape.name+"/"+tt.name, func(t *testing.T) {
+				if got := search(head, tt.target, shape.circular, n); got != tt.want {
+					t.Errorf("search(%d) = %t, want %t", tt.target, got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestSearchEmpty(t *testing.T) {
+	if search(nil, 0, false, 0) {
+		t.Error("search on an empty list reported a match")
+	}
+}
+
+func BenchmarkLinkedListSearch(b *testing.B) {
+	const n = 1_000_000
+	for _, shape := range listShapes {
+		head := createList(n, shape.doubly, shape.circular)
+		cases := []struct {
+			name   string
+			target int
+		}{
+			{"best", 0},
+			{"average", n / 2},
+			{"worst", n - 1},
+			{"not found", -1},
+		}
+		for _, c := range cases {
+			b.Run(fmt.Sprintf("%s/%s", shape.name, c.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					search(head, c.target, shape.circular, n)
+				}
+			})
+		}
+	}
+}
+
+// cycleList builds n nodes whose tail links back to the node at index
+// cycleAt, or is nil-terminated when cycleAt is -1.
+func cycleList(n, cycleAt int) (head, start *Node[int]) {
+	head = createList(n, false, false)
+	if cycleAt < 0 || head == nil {
+		return head, nil
+	}
+	tail := head
+	for tail.next != nil {
+		tail = tail.next
+	}
+	start = head
+	for i := 0; i < cycleAt; i++ {
+		start = start.next
+	}
+	tail.next = start
+	return head, start
+}
+
+func TestDetectCycleAndLength(t *testing.T) {
+	tests := []struct {
+		name       string
+		n, cycleAt int
+	}{
+		{"empty", 0, -1},
+		{"single node", 1, -1},
+		{"self loop", 1, 0},
+		{"acyclic", 6, -1},
+		{"fully circular", 6, 0},
+		{"cycle partway", 6, 3},
+		{"tail points to itself", 6, 5},
+		{"two-node ring after a long tail", 50, 48},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			head, want := cycleList(tt.n, tt.cycleAt)
+			got, cyclic := DetectCycle(head)
+			if cyclic != (tt.cycleAt >= 0) || got != want {
+				t.Errorf("DetectCycle = (%v, %t), want (%v, %t)", got, cyclic, want, tt.cycleAt >= 0)
+			}
+			if got := Length(head); got != tt.n {
+				t.Errorf("Length = %d, want %d", got, tt.n)
+			}
+		})
+	}
+}
+
+func TestLengthOfCreatedLists(t *testing.T) {
+	for _, shape := range listShapes {
+		if got := Length(createList(7, shape.doubly, shape.circular)); got != 7 {
+			t.Errorf("%s: Length = %d, want 7", shape.name, got)
+		}
+	}
+}
+
+func TestGenericStringList(t *testing.T) {
+	words := []string{"alpha", "beta", "gamma", "delta"}
+	for _, shape := range listShapes {
+		head := listFromSlice(words, shape.doubly, shape.circular)
+		for _, w := range words {
+			if !search(head, w, shape.circular, len(words)) {
+				t.Errorf("%s: search(%q) = false, want true", shape.name, w)
+			}
+		}
+		if search(head, "epsilon", shape.circular, len(words)) {
+			t.Errorf("%s: search(%q) = true, want false", shape.name, "epsilon")
+		}
+		if got := Length(head); got != len(words) {
+			t.Errorf("%s: Length = %d, want %d", shape.name, got, len(words))
+		}
+	}
+}
+
+func TestSearchFuncComparator(t *testing.T) {
+	type point struct{ x, y int }
+	head := listFromSlice([]point{{1, 2}, {3, 4}}, true, true)
+	sameX := func(a, b point) bool { return a.x == b.x }
+	if !searchFunc(head, point{3, 99}, sameX, true, 2) {
+		t.Error("searchFunc did not match on x alone")
+	}
+	if searchFunc(head, point{5, 4}, sameX, true, 2) {
+		t.Error("searchFunc matched a point with a different x")
+	}
+}
+
+func TestGenericListMutations(t *testing.T) {
+	for _, shape := range listShapes {
+		l := NewList[string](shape.doubly, shape.circular)
+		for i, w := range []string{"b", "d"} {
+			if err := l.InsertAt(i, w); err != nil {
+				t.Fatal(err)
+			}
+		}
+		l.InsertAt(0, "a")
+		l.InsertAt(2, "c")
+		l.Reverse()
+		if !l.DeleteValue("c") || l.DeleteValue("z") {
+			t.Errorf("%s: DeleteValue results wrong", shape.name)
+		}
+		if got := l.Values(); !slices.Equal(got, []string{"d", "b", "a"}) {
+			t.Errorf("%s: Values = %v, want [d b a]", shape.name, got)
+		}
+		if err := l.checkLinks(); err != nil {
+			t.Errorf("%s: %v", shape.name, err)
+		}
+	}
+}
+
+func TestSortList(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	sorts := map[string]func(*Node[int]) *Node[int]{
+		"top-down":  sortListTopDown[int],
+		"bottom-up": sortListBottomUp[int],
+	}
+	for name, sortList := range sorts {
+		for _, n := range []int{0, 1, 2, 3, 7, 8, 9, 100, 1023} {
+			random := make([]int, n)
+			for i := range random {
+				random[i] = rng.Intn(n/2 + 1) // Plenty of duplicates
+			}
+			want := slices.Sorted(slices.Values(random))
+			if got := listValues(sortList(listFromSlice(random, false, false))); !slices.Equal(got, want) {
+				t.Errorf("%s, n=%d random: got %v, want %v", name, n, got, want)
+			}
+			if got := listValues(sortList(createList(n, false, false))); len(got) != n || !slices.IsSorted(got) {
+				t.Errorf("%s, n=%d already sorted: got %v", name, n, got)
+			}
+		}
+	}
+}
+
+func BenchmarkSortList(b *testing.B) {
+	const n = 1_000_000
+	values := rand.New(rand.NewSource(1)).Perm(n)
+	sorts := []struct {
+		name string
+		sort func(*Node[int]) *Node[int]
+	}{
+		{"top-down", sortListTopDown[int]},
+		{"bottom-up", sortListBottomUp[int]},
+	}
+	for _, s := range sorts {
+		b.Run(s.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				head := listFromSlice(values, false, false)
+				b.StartTimer()
+				s.sort(head)
+			}
+		})
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+	}{
+		{"both nil", nil, nil},
+		{"a nil", nil, []int{1, 2}},
+		{"b nil", []int{1, 2}, nil},
+		{"interleaved", []int{1, 4, 6}, []int{2, 3, 5, 7}},
+		{"duplicates", []int{1, 2, 2, 5}, []int{2, 2, 3, 5}},
+		{"a entirely smaller", []int{1, 2, 3}, []int{10, 11}},
+		{"b entirely smaller", []int{10, 11}, []int{1, 2, 3}},
+		{"single nodes", []int{5}, []int{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := listFromSlice(tt.a, false, false)
+			b := listFromSlice(tt.b, false, false)
+			nodes := map[*Node[int]]bool{}
+			for _, head := range []*Node[int]{a, b} {
+				for node := head; node != nil; node = node.next {
+					nodes[node] = true
+				}
+			}
+
+			merged := MergeSorted(a, b)
+			if _, cyclic := DetectCycle(merged); cyclic {
+				t.Fatal("merge produced a cycle")
+			}
+			want := slices.Sorted(slices.Values(append(slices.Clone(tt.a), tt.b...)))
+			if got := listValues(merged); !slices.Equal(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+			for node := merged; node != nil; node = node.next {
+				if !nodes[node] {
+					t.Fatal("merge allocated a new node")
+				}
+			}
+		})
+	}
+}
+
+func TestRemoveNthFromEnd(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	tests := []struct {
+		name string
+		n    int
+		want []int
+	}{
+		{"tail", 1, []int{1, 2, 3, 4}},
+		{"middle", 3, []int{1, 2, 4, 5}},
+		{"head", 5, []int{2, 3, 4, 5}},
+	}
+	for _, doubly := range []bool{false, true} {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("doubly=%t/%s", doubly, tt.name), func(t *testing.T) {
+				head, err := RemoveNthFromEnd(listFromSlice(values, doubly, false), tt.n)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := listValues(head); !slices.Equal(got, tt.want) {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+				var prev *Node[int]
+				for node := head; node != nil; prev, node = node, node.next {
+					if doubly && node.prev != prev {
+						t.Fatalf("prev link of %d does not point at its predecessor", node.data)
+					}
+				}
+			})
+		}
+	}
+
+	single, err := RemoveNthFromEnd(listFromSlice([]int{7}, true, false), 1)
+	if single != nil || err != nil {
+		t.Errorf("removing the only node = (%v, %v), want (nil, nil)", single, err)
+	}
+
+	for _, n := range []int{0, -1, 6} {
+		head := listFromSlice(values, true, false)
+		got, err := RemoveNthFromEnd(head, n)
+		if err == nil {
+			t.Errorf("n=%d: expected an error", n)
+		}
+		if got != head || !slices.Equal(listValues(got), values) {
+			t.Errorf("n=%d: list changed on error: %v", n, listValues(got))
+		}
+	}
+	if _, err := RemoveNthFromEnd[int](nil, 1); err == nil {
+		t.Error("n=1 on an empty list: expected an error")
+	}
+
+	for _, shape := range listShapes {
+		if !shape.circular {
+			continue
+		}
+		// n past the cycle length is caught while lead is still getting ahead
+		for _, n := range []int{1, 5, 100} {
+			head := createList(5, shape.doubly, true)
+			got, err := RemoveNthFromEnd(head, n)
+			if err == nil {
+				t.Errorf("%s, n=%d: expected an error for a circular list", shape.name, n)
+			}
+			if got != head || Length(got) != 5 {
+				t.Errorf("%s, n=%d: list changed on error", shape.name, n)
+			}
+		}
+	}
+	for _, n := range []int{2, 50} {
+		rho, _ := cycleList(6, 3) // Tail links back into the middle
+		if _, err := RemoveNthFromEnd(rho, n); err == nil {
+			t.Errorf("n=%d: expected an error for a list whose tail loops into the middle", n)
+		}
+	}
+}
+
+func TestListEqual(t *testing.T) {
+	for _, shape := range listShapes {
+		a := createList(5, shape.doubly, shape.circular)
+		if !listEqual(a, createList(5, shape.doubly, shape.circular)) {
+			t.Errorf("%s: identical lists compare unequal", shape.name)
+		}
+		if !listEqual(a, a) {
+			t.Errorf("%s: list does not equal itself", shape.name)
+		}
+	}
+
+	rhoA, _ := cycleList(6, 3)
+	rhoB, _ := cycleList(6, 3)
+	if !listEqual(rhoA, rhoB) {
+		t.Error("identical rho-shaped lists compare unequal")
+	}
+
+	differentTail, _ := cycleList(6, 2) // Same values, loop entered earlier
+	tests := []struct {
+		name string
+		a, b *Node[int]
+	}{
+		{"singly vs doubly", createList(5, false, false), createList(5, true, false)},
+		{"linear vs circular", createList(5, false, false), createList(5, false, true)},
+		{"different lengths", createList(5, false, false), createList(6, false, false)},
+		{"circular, different lengths", createList(5, false, true), createList(6, false, true)},
+		{"different values", createList(3, false, false), listFromSlice([]int{0, 1, 7}, false, false)},
+		{"rho vs circular", rhoA, createList(6, false, true)},
+		{"rho, different cycle entry", rhoA, differentTail},
+		{"empty vs non-empty", nil, createList(1, false, false)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if listEqual(tt.a, tt.b) || listEqual(tt.b, tt.a) {
+				t.Error("differently shaped lists compare equal")
+			}
+		})
+	}
+	if !listEqual[int](nil, nil) {
+		t.Error("two empty lists compare unequal")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	results := []benchmarkResult{
+		{"Singly", "First", 0.000001},
+		{"Doubly, circular", "Not found", 1.5}, // Comma must be quoted
+		{"Circular Singly", "Last", 0},
+	}
+	var buf bytes.Buffer
+	if err := writeCSV(&buf, results); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("output is not valid CSV: %v", err)
+	}
+	if len(records) != len(results)+1 {
+		t.Fatalf("got %d rows, want header plus %d", len(records), len(results))
+	}
+	if want := []string{"list_type", "position", "seconds"}; !slices.Equal(records[0], want) {
+		t.Errorf("header = %v, want %v", records[0], want)
+	}
+	if want := []string{"Doubly, circular", "Not found", "1.5"}; !slices.Equal(records[2], want) {
+		t.Errorf("row 2 = %v, want %v", records[2], want)
+	}
+
+	buf.Reset()
+	if err := writeCSV(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if records, _ := csv.NewReader(&buf).ReadAll(); len(records) != 1 {
+		t.Errorf("no results: got %d rows, want just the header", len(records))
+	}
+}
+
+// coefficients flattens p into exponent -> coefficient, checking on the way
+// that the list is in strictly descending exponent order with no zero terms.
+func coefficients(t *testing.T, p *Polynomial) map[int]float64 {
+	t.Helper()
+	coefs := map[int]float64{}
+	for term := p.head; term != nil; term = term.next {
+		if term.coef == 0 {
+			t.Errorf("%v keeps a zero term x^%d", p, term.exp)
+		}
+		if term.next != nil && term.next.exp >= term.exp {
+			t.Errorf("%v is not in descending exponent order", p)
+		}
+		coefs[term.exp] = term.coef
+	}
+	return coefs
+}
+
+func TestPolynomialAddMultiply(t *testing.T) {
+	tests := []struct {
+		name      string
+		p, q      map[int]float64
+		sum, prod map[int]float64
+	}{
+		{
+			name: "like exponents combine",
+			p:    map[int]float64{2: 3, 1: 2, 0: 1},
+			q:    map[int]float64{3: 1, 1: -2, 0: 4},
+			sum:  map[int]float64{3: 1, 2: 3, 0: 5},
+			// (3x^2 + 2x + 1)(x^3 - 2x + 4), expanded by hand
+			prod: map[int]float64{5: 3, 4: 2, 3: -5, 2: 8, 1: 6, 0: 4},
+		},
+		{
+			name: "difference of squares cancels the middle",
+			p:    map[int]float64{1: 1, 0: 1},
+			q:    map[int]float64{1: 1, 0: -1},
+			sum:  map[int]float64{1: 2},
+			prod: map[int]float64{2: 1, 0: -1},
+		},
+		{
+			name: "sum cancels to zero",
+			p:    map[int]float64{3: 2, 0: -1},
+			q:    map[int]float64{3: -2, 0: 1},
+			sum:  map[int]float64{},
+			prod: map[int]float64{6: -4, 3: 4, 0: -1},
+		},
+		{
+			name: "multiply by zero",
+			p:    map[int]float64{4: 7, 1: -3},
+			q:    map[int]float64{},
+			sum:  map[int]float64{4: 7, 1: -3},
+			prod: map[int]float64{},
+		},
+		{
+			name: "negative exponents",
+			p:    map[int]float64{1: 3, -1: 2},
+			q:    map[int]float64{1: 1},
+			sum:  map[int]float64{1: 4, -1: 2},
+			prod: map[int]float64{2: 3, 0: 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, q := NewPolynomial(tt.p), NewPolynomial(tt.q)
+			if got := coefficients(t, p.Add(q)); !maps.Equal(got, tt.sum) {
+				t.Errorf("(%v) + (%v) = %v, want %v", p, q, got, tt.sum)
+			}
+			if got := coefficients(t, q.Add(p)); !maps.Equal(got, tt.sum) {
+				t.Errorf("Add is not commutative: (%v) + (%v) = %v", q, p, got)
+			}
+			if got := coefficients(t, p.Multiply(q)); !maps.Equal(got, tt.prod) {
+				t.Errorf("(%v) * (%v) = %v, want %v", p, q, got, tt.prod)
+			}
+			if got := coefficients(t, q.Multiply(p)); !maps.Equal(got, tt.prod) {
+				t.Errorf("Multiply is not commutative: (%v) * (%v) = %v", q, p, got)
+			}
+		})
+	}
+}
+
+func TestPolynomialEvaluate(t *testing.T) {
+	tests := []struct {
+		coefs map[int]float64
+		x     float64
+		want  float64
+	}{
+		{map[int]float64{}, 3, 0},
+		{map[int]float64{0: 5}, 3, 5},
+		{map[int]float64{2: 3, 1: 2, 0: 1}, 2, 17},
+		{map[int]float64{5: 1}, 2, 32}, // Gap below the only term
+		{map[int]float64{1: 3, -1: 2}, 2, 7},
+		{map[int]float64{-1: 2}, 2, 1},
+		{map[int]float64{-2: 8, -3: 8}, 2, 3},
+	}
+	for _, tt := range tests {
+		p := NewPolynomial(tt.coefs)
+		if got := p.Evaluate(tt.x); got != tt.want {
+			t.Errorf("(%v)(%g) = %g, want %g", p, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestPolynomialString(t *testing.T) {
+	tests := []struct {
+		coefs map[int]float64
+		want  string
+	}{
+		{map[int]float64{}, "0"},
+		{map[int]float64{2: 3, 1: 2, 0: 1}, "3x^2 + 2x + 1"},
+		{map[int]float64{2: -1, 0: 4}, "-x^2 + 4"},
+		{map[int]float64{1: -1}, "-x"},
+		{map[int]float64{0: -1}, "-1"},
+		{map[int]float64{3: 1, 1: -1, 0: -2}, "x^3 - x - 2"},
+		{map[int]float64{1: 3, -1: 2}, "3x + 2x^-1"},
+	}
+	for _, tt := range tests {
+		if got := NewPolynomial(tt.coefs).String(); got != tt.want {
+			t.Errorf("String of %v = %q, want %q", tt.coefs, got, tt.want)
+		}
+	}
+}
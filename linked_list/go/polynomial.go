@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type term struct {
+	coef float64
+	exp  int
+	next *term
+}
+
+// Polynomial keeps its terms in a singly linked list sorted by descending
+// exponent, with like exponents combined and zero coefficients dropped.
+type Polynomial struct {
+	head *term
+}
+
+func NewPolynomial(coefs map[int]float64) *Polynomial {
+	p := &Polynomial{}
+	for exp, coef := range coefs {
+		p.AddTerm(coef, exp)
+	}
+	return p
+}
+
+func (p *Polynomial) AddTerm(coef float64, exp int) {
+	if coef == 0 {
+		return
+	}
+	link := &p.head
+	for *link != nil && (*link).exp > exp {
+		link = &(*link).next
+	}
+	if *link != nil && (*link).exp == exp {
+		(*link).coef += coef
+		if (*link).coef == 0 {
+			*link = (*link).next
+		}
+		return
+	}
+	*link = &term{coef: coef, exp: exp, next: *link}
+}
+
+func (p *Polynomial) Add(other *Polynomial) *Polynomial {
+	result := &Polynomial{}
+	tail := &result.head
+	a, b := p.head, other.head
+	for a != nil || b != nil {
+		var coef float64
+		var exp int
+		switch {
+		case b == nil || (a != nil && a.exp > b.exp):
+			coef, exp = a.coef, a.exp
+			a = a.next
+		case a == nil || b.exp > a.exp:
+			coef, exp = b.coef, b.exp
+			b = b.next
+		default:
+			coef, exp = a.coef+b.coef, a.exp
+			a, b = a.next, b.next
+		}
+		if coef != 0 {
+			*tail = &term{coef: coef, exp: exp}
+			tail = &(*tail).next
+		}
+	}
+	return result
+}
+
+func (p *Polynomial) Multiply(other *Polynomial) *Polynomial {
+	result := &Polynomial{}
+	for a := p.head; a != nil; a = a.next {
+		for b := other.head; b != nil; b = b.next {
+			result.AddTerm(a.coef*b.coef, a.exp+b.exp)
+		}
+	}
+	return result
+}
+
+// Evaluate computes p(x) by Horner's rule. Negative exponents are allowed:
+// after the last term the result is scaled by x^exp whatever its sign, so
+// x == 0 gives an infinity in that case.
+func (p *Polynomial) Evaluate(x float64) float64 {
+	// Horner's rule, multiplying by x once per exponent step between terms
+	if p.head == nil {
+		return 0
+	}
+	result := 0.0
+	exp := p.head.exp
+	for t := p.head; t != nil; t = t.next {
+		for ; exp > t.exp; exp-- {
+			result *= x
+		}
+		result += t.coef
+	}
+	for ; exp > 0; exp-- {
+		result *= x
+	}
+	for ; exp < 0; exp++ {
+		result /= x // The lowest term had a negative exponent
+	}
+	return result
+}
+
+func (p *Polynomial) String() string {
+	if p.head == nil {
+		return "0"
+	}
+	var sb strings.Builder
+	for t := p.head; t != nil; t = t.next {
+		coef := t.coef
+		switch {
+		case t == p.head && coef < 0:
+			sb.WriteString("-")
+			coef = -coef
+		case t == p.head:
+		case coef < 0:
+			sb.WriteString(" - ")
+			coef = -coef
+		default:
+			sb.WriteString(" + ")
+		}
+		if coef != 1 || t.exp == 0 {
+			fmt.Fprintf(&sb, "%g", coef)
+		}
+		if t.exp == 1 {
+			sb.WriteString("x")
+		} else if t.exp != 0 {
+			fmt.Fprintf(&sb, "x^%d", t.exp)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,293 @@
+// h1 -- Ring Buffer Implementation in Go
+// h2 -- Fixed-capacity FIFO queue backed by a circular slice
+// h2 -- Includes a sliding-window rate limiter and window statistics built on top of it
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// h3 -- Ring Buffer Type
+// h4 -- Stores up to cap(data) elements, wrapping head around the slice
+// h5 -- data: Backing storage allocated once at construction
+// h5 -- head: Index of the oldest element
+// h5 -- size: Number of stored elements
+type ringBuffer[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+// h3 -- Ring Buffer Constructor
+// h4 -- Allocates a buffer holding at most capacity elements
+// h6 -- Panics: when capacity is not positive
+func newRingBuffer[T any](capacity int) *ringBuffer[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("ring buffer capacity must be positive, got %d", capacity))
+	}
+	return &ringBuffer[T]{data: make([]T, capacity)}
+}
+
+// h3 -- Ring Buffer Accessors
+// h4 -- Constant-time size queries
+func (r *ringBuffer[T]) Len() int   { return r.size }
+func (r *ringBuffer[T]) Full() bool { return r.size == len(r.data) }
+
+// h3 -- Push Function
+// h4 -- Appends v at the tail in O(1)
+// h6 -- Returns: false (leaving the buffer unchanged) when the buffer is full
+func (r *ringBuffer[T]) Push(v T) bool {
+	if r.Full() {
+		return false
+	}
+	r.data[(r.head+r.size)%len(r.data)] = v
+	r.size++
+	return true
+}
+
+// h3 -- Front Function
+// h4 -- Returns the oldest element without removing it
+// h6 -- Returns: Element and true, or the zero value and false when empty
+func (r *ringBuffer[T]) Front() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.data[r.head], true
+}
+
+// h3 -- At Function
+// h4 -- Returns the i-th oldest element (0 is the front); i must be in [0, Len())
+func (r *ringBuffer[T]) At(i int) T {
+	return r.data[(r.head+i)%len(r.data)]
+}
+
+// h3 -- Pop Function
+// h4 -- Removes and returns the oldest element in O(1)
+// h6 -- Returns: Element and true, or the zero value and false when empty
+func (r *ringBuffer[T]) Pop() (T, bool) {
+	v, ok := r.Front()
+	if !ok {
+		return v, false
+	}
+	var zero T
+	r.data[r.head] = zero // Release references held by the slot
+	r.head = (r.head + 1) % len(r.data)
+	r.size--
+	return v, true
+}
+
+// h3 -- Sliding Window Limiter Type
+// h4 -- Admits at most limit requests in any window-long period
+// h5 -- timestamps: Admitted request times, oldest first
+// h5 -- now: Clock source, injectable for deterministic testing
+type SlidingWindowLimiter struct {
+	limit      int
+	window     time.Duration
+	timestamps *ringBuffer[time.Time]
+	now        func() time.Time
+}
+
+// h3 -- Sliding Window Limiter Constructor
+// h5 -- limit: Maximum requests admitted per window (must be positive)
+// h5 -- window: Length of the sliding window
+// h5 -- now: Clock source; nil uses time.Now
+func NewSlidingWindowLimiter(limit int, window time.Duration, now func() time.Time) *SlidingWindowLimiter {
+	if now == nil {
+		now = time.Now
+	}
+	return &SlidingWindowLimiter{
+		limit:      limit,
+		window:     window,
+		timestamps: newRingBuffer[time.Time](limit),
+		now:        now,
+	}
+}
+
+// h3 -- Allow Function
+// h4 -- Evicts timestamps that left the window, then admits the request if room remains
+// h6 -- Returns: true when the request is admitted (and recorded)
+// h6 -- Time Complexity: O(1) amortized - each timestamp is evicted once
+func (l *SlidingWindowLimiter) Allow() bool {
+	t := l.now()
+	for {
+		oldest, ok := l.timestamps.Front()
+		if !ok || t.Sub(oldest) < l.window {
+			break
+		}
+		l.timestamps.Pop()
+	}
+	return l.timestamps.Push(t)
+}
+
+// h3 -- Window Statistics Type
+// h4 -- Mean and population variance of the last k values with constant-time updates
+// h5 -- window: The last k raw values, oldest first
+// h5 -- mean: Mean of the values in the window
+// h5 -- m2: Sum of squared deviations from mean; variance is m2 / n
+// h5 -- pushes: Updates since mean and m2 were last recomputed from the window
+// h6 -- Note: Welford-style updates work on deviations from the current mean, so unlike
+// h6 --       var = E[x²] - E[x]² they never subtract two large nearly equal sums. Sliding
+// h6 --       updates still accumulate rounding error, so every k pushes both are recomputed
+// h6 --       exactly from the window in O(k), keeping the error bounded on endless streams.
+// h6 --       They are also recomputed at once when an eviction shrinks m2 by over 2^20, as
+// h6 --       when an outlier leaves, since m2's rounding error then dwarfs what remains.
+type WindowStats struct {
+	window *ringBuffer[float64]
+	mean   float64
+	m2     float64
+	pushes int
+}
+
+// h3 -- Window Statistics Constructor
+// h5 -- k: Window size
+// h6 -- Panics: when k is not positive
+func NewWindowStats(k int) *WindowStats {
+	return &WindowStats{window: newRingBuffer[float64](k)}
+}
+
+// h3 -- Next Function
+// h4 -- Adds v, evicting the oldest value once the window is full
+// h6 -- Returns: Mean and population variance of the values currently in the window
+// h6 --          (fewer than k during the fill phase)
+// h6 -- Time Complexity: O(1) amortized, plus O(k) when an outlier leaves the window
+func (w *WindowStats) Next(v float64) (mean, variance float64) {
+	w.pushes++
+	recompute := w.pushes == len(w.window.data)
+	if w.window.Full() {
+		// Replace old with v in one step: the count stays k
+		old, _ := w.window.Pop()
+		w.window.Push(v)
+		oldMean, oldM2 := w.mean, w.m2
+		delta := v - old
+		w.mean += delta / float64(w.window.Len())
+		w.m2 += delta * (v - w.mean + old - oldMean)
+		if w.m2 < oldM2*0x1p-20 {
+			// Evicting an outlier cancelled most of m2, leaving rounding error
+			// from its old magnitude that is large next to the new value
+			recompute = true
+		}
+	} else {
+		// Standard Welford insertion while the window fills
+		w.window.Push(v)
+		delta := v - w.mean
+		w.mean += delta / float64(w.window.Len())
+		w.m2 += delta * (v - w.mean)
+	}
+
+	if recompute {
+		w.resync()
+	}
+	return w.mean, max(w.m2/float64(w.window.Len()), 0)
+}
+
+// h3 -- Resync Function
+// h4 -- Recomputes mean and m2 from the window with the two-pass formula, discarding drift
+func (w *WindowStats) resync() {
+	n := w.window.Len()
+	sum := 0.0
+	for i := 0; i < n; i++ {
+		sum += w.window.At(i)
+	}
+	w.mean = sum / float64(n)
+	w.m2 = 0
+	for i := 0; i < n; i++ {
+		d := w.window.At(i) - w.mean
+		w.m2 += d * d
+	}
+	w.pushes = 0
+}
+
+// h3 -- Brute-Force Window Statistics
+// h4 -- Two-pass mean and variance of a slice, used as the reference
+func bruteStats(values []float64) (mean, variance float64) {
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(len(values))
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	return mean, variance / float64(len(values))
+}
+
+func main() {
+	fmt.Println("=== RING BUFFER - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Basic Functionality Test
+	// h4 -- Fills the buffer, wraps around, and drains it
+	fmt.Println("1. BASIC FUNCTIONALITY TEST")
+	fmt.Println("===========================")
+
+	buf := newRingBuffer[int](3)
+	for i := 1; i <= 4; i++ {
+		fmt.Printf("Push %d: %t\n", i, buf.Push(i))
+	}
+	v, _ := buf.Pop()
+	fmt.Printf("Pop: %d\n", v)
+	fmt.Printf("Push 5 (wraps around): %t\n", buf.Push(5))
+	for buf.Len() > 0 {
+		v, _ := buf.Pop()
+		fmt.Printf("Pop: %d\n", v)
+	}
+
+	// h3 -- Rate Limiter Demonstration
+	// h4 -- Uses a fake clock advanced by hand to show window expiry
+	fmt.Println("\n2. SLIDING WINDOW RATE LIMITER")
+	fmt.Println("==============================")
+
+	clock := time.Unix(0, 0)
+	limiter := NewSlidingWindowLimiter(3, time.Second, func() time.Time { return clock })
+	for step := 0; step < 8; step++ {
+		fmt.Printf("t=%4dms allow: %t\n", clock.Sub(time.Unix(0, 0)).Milliseconds(), limiter.Allow())
+		clock = clock.Add(250 * time.Millisecond)
+	}
+
+	// h3 -- Window Statistics
+	// h4 -- Fill phase step by step, then long streams around a large offset
+	fmt.Println("\n3. SLIDING WINDOW STATISTICS")
+	fmt.Println("============================")
+
+	stats := NewWindowStats(3)
+	for _, x := range []float64{2, 4, 6, 8, 100, 100, 100} {
+		mean, variance := stats.Next(x)
+		fmt.Printf("Next(%3g): mean %7.3f, variance %8.3f\n", x, mean, variance)
+	}
+
+	// Values near 1e9 with unit spread: the unshifted formula loses every digit here
+	const k = 50
+	rng := rand.New(rand.NewSource(7))
+	stream := make([]float64, 10_000)
+	for i := range stream {
+		stream[i] = 1e9 + rng.NormFloat64()
+	}
+	stats = NewWindowStats(k)
+	maxMeanErr, maxVarErr := 0.0, 0.0
+	for i, x := range stream {
+		mean, variance := stats.Next(x)
+		wantMean, wantVar := bruteStats(stream[max(0, i-k+1) : i+1])
+		maxMeanErr = max(maxMeanErr, math.Abs(mean-wantMean))
+		maxVarErr = max(maxVarErr, math.Abs(variance-wantVar))
+	}
+	fmt.Printf("%d values around 1e9, k=%d: max mean error %.2g, max variance error %.2g\n",
+		len(stream), k, maxMeanErr, maxVarErr)
+
+	// A stream that starts far from where it settles: a fixed reference value
+	// taken from the first sample would be useless after the first window
+	stream[0] = 0
+	stats = NewWindowStats(k)
+	maxVarErr = 0
+	for i, x := range stream {
+		_, variance := stats.Next(x)
+		_, wantVar := bruteStats(stream[max(0, i-k+1) : i+1])
+		if i >= k {
+			maxVarErr = max(maxVarErr, math.Abs(variance-wantVar))
+		}
+	}
+	fmt.Printf("Same stream after a leading 0: max variance error %.2g once 0 leaves the window\n", maxVarErr)
+}
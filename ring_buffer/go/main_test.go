@@ -0,0 +1,103 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// checkAgainstBrute feeds stream through a window of size k and compares
+// every result with the two-pass statistics of the same window.
+func checkAgainstBrute(t *testing.T, stream []float64, k int, meanTol, varTol float64) {
+	t.Helper()
+	stats := NewWindowStats(k)
+	for i, x := range stream {
+		mean, variance := stats.Next(x)
+		wantMean, wantVar := bruteStats(stream[max(0, i-k+1) : i+1])
+		if math.Abs(mean-wantMean) > meanTol || math.Abs(variance-wantVar) > varTol {
+			t.Fatalf("k=%d, push %d (%g): got mean %g, variance %g, want %g, %g",
+				k, i, x, mean, variance, wantMean, wantVar)
+		}
+	}
+}
+
+func TestWindowStatsFillPhase(t *testing.T) {
+	stats := NewWindowStats(3)
+	tests := []struct {
+		x, mean, variance float64
+	}{
+		{2, 2, 0},                     // [2]
+		{4, 3, 1},                     // [2 4]
+		{6, 4, 8.0 / 3},               // [2 4 6], window now full
+		{8, 6, 8.0 / 3},               // [4 6 8]
+		{100, 38, 5768.0 / 3},         // [6 8 100]
+		{100, 208.0 / 3, 16928.0 / 9}, // [8 100 100]
+		{100, 100, 0},                 // [100 100 100]
+	}
+	for _, tt := range tests {
+		mean, variance := stats.Next(tt.x)
+		if math.Abs(mean-tt.mean) > 1e-12 || math.Abs(variance-tt.variance) > 1e-9 {
+			t.Errorf("Next(%g) = mean %g, variance %g, want %g, %g", tt.x, mean, variance, tt.mean, tt.variance)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for _, k := range []int{1, 2, 5, 50} {
+		stream := make([]float64, 3*k+7) // Fill, several full windows, a partial resync period
+		for i := range stream {
+			stream[i] = rng.Float64()*200 - 100
+		}
+		checkAgainstBrute(t, stream, k, 1e-12, 1e-9)
+	}
+}
+
+func TestWindowStatsLargeOffset(t *testing.T) {
+	const k = 50
+	rng := rand.New(rand.NewSource(7))
+	stream := make([]float64, 10_000)
+	for i := range stream {
+		stream[i] = 1e9 + rng.NormFloat64() // Unit spread: E[x²] - E[x]² would lose every digit
+	}
+	checkAgainstBrute(t, stream, k, 1e-5, 1e-5) // 1e-5 is ~100 ulps of the mean
+
+	// An outlier far from where the stream settles must not poison the
+	// variance once it leaves the window
+	stream[0] = 0
+	stats := NewWindowStats(k)
+	for i, x := range stream {
+		_, variance := stats.Next(x)
+		if i < k {
+			continue // The outlier is still in the window, so the variance is ~2e16
+		}
+		if _, want := bruteStats(stream[i-k+1 : i+1]); math.Abs(variance-want) > 1e-5 {
+			t.Fatalf("push %d, outlier evicted: variance %g, want %g", i, variance, want)
+		}
+	}
+}
+
+func TestWindowStatsConstantWindow(t *testing.T) {
+	for _, c := range []float64{0, 0.1, -3.7, 1e9 + 0.1, 1e300} {
+		stats := NewWindowStats(5)
+		for i := 0; i < 23; i++ {
+			if mean, variance := stats.Next(c); mean != c || variance != 0 {
+				t.Fatalf("constant %g, push %d: mean %g, variance %g, want %g, 0", c, i, mean, variance, c)
+			}
+		}
+	}
+
+	// After a varying prefix, a window of equal values must report no spread
+	// (beyond the rounding of its own mean) and never a negative variance
+	stats := NewWindowStats(5)
+	for _, x := range []float64{1e9, -1e9, 3, 1e-9, 42} {
+		stats.Next(x)
+	}
+	for i := 0; i < 20; i++ {
+		_, variance := stats.Next(0.1)
+		if variance < 0 {
+			t.Fatalf("push %d: negative variance %g", i, variance)
+		}
+		if i >= 4 && variance > 1e-30 {
+			t.Fatalf("push %d: window is all 0.1 but variance is %g", i, variance)
+		}
+	}
+}
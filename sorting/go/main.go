@@ -0,0 +1,453 @@
+// h1 -- Sorting Algorithms Implementation in Go
+// h2 -- Merge-based sorting with both buffered and in-place merging
+// h2 -- Compares the two merge strategies on identical inputs
+// h2 -- Also covers parallel merge sort, distribution (bucket) sorting and selection
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+// h3 -- Reverse Helper
+// h4 -- Reverses a slice in place using two converging indices
+func reverse(arr []int) {
+	for i, j := 0, len(arr)-1; i < j; i, j = i+1, j-1 {
+		arr[i], arr[j] = arr[j], arr[i]
+	}
+}
+
+// h3 -- Rotate Helper
+// h4 -- Rotates arr left by m positions with three reversals (block swap)
+// h6 -- Time Complexity: O(n), Space Complexity: O(1)
+func rotate(arr []int, m int) {
+	reverse(arr[:m])
+	reverse(arr[m:])
+	reverse(arr)
+}
+
+// h3 -- Copy-Based Merge Function
+// h4 -- Merges sorted regions [lo,mid) and [mid,hi) through a temporary buffer
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func mergeCopy(arr []int, lo, mid, hi int) {
+	merged := make([]int, 0, hi-lo)
+	i, j := lo, mid
+	for i < mid && j < hi {
+		if arr[i] <= arr[j] {
+			merged = append(merged, arr[i])
+			i++
+		} else {
+			merged = append(merged, arr[j])
+			j++
+		}
+	}
+	merged = append(merged, arr[i:mid]...)
+	merged = append(merged, arr[j:hi]...)
+	copy(arr[lo:hi], merged)
+}
+
+// h3 -- In-Place Merge Function
+// h4 -- Merges sorted regions [lo,mid) and [mid,hi) using rotations instead of a buffer
+// h5 -- arr: Slice holding both regions
+// h5 -- lo, mid, hi: Region boundaries (lo <= mid <= hi)
+// h6 -- Time Complexity: O(n²) worst case - each rotation may shift the left run
+// h6 -- Space Complexity: O(1) - trades time for memory
+// h6 -- Note: Stable; equal elements keep their left-before-right order
+func mergeInPlace(arr []int, lo, mid, hi int) {
+	i, j := lo, mid
+	for i < j && j < hi {
+		if arr[i] <= arr[j] {
+			i++ // arr[i] is already in its final position
+			continue
+		}
+
+		// Collect the run of right-hand elements that belong before arr[i]
+		k := j + 1
+		for k < hi && arr[k] < arr[i] {
+			k++
+		}
+
+		// Move arr[j:k] in front of the remaining left region
+		rotate(arr[i:k], j-i)
+		i += k - j + 1 // Skip the moved run and the old arr[i]
+		j = k
+	}
+}
+
+// h3 -- Merge Sort Function
+// h4 -- Bottom-up merge sort parameterized by the merge strategy
+// h5 -- merge: Either mergeCopy or mergeInPlace
+// h6 -- Time Complexity: O(n log n) with mergeCopy
+func mergeSort(arr []int, merge func(arr []int, lo, mid, hi int)) {
+	n := len(arr)
+	for width := 1; width < n; width *= 2 {
+		for lo := 0; lo < n-width; lo += 2 * width {
+			merge(arr, lo, lo+width, min(lo+2*width, n))
+		}
+	}
+}
+
+// h3 -- Merge Comparison Function
+// h4 -- Runs both merge strategies on copies of the same input and reports agreement
+func compareMerges(name string, arr []int, lo, mid, hi int) {
+	viaCopy := slices.Clone(arr)
+	mergeCopy(viaCopy, lo, mid, hi)
+
+	inPlace := slices.Clone(arr)
+	mergeInPlace(inPlace, lo, mid, hi)
+
+	fmt.Printf("  %s: %v -> %v (matches copy merge: %t)\n",
+		name, arr, inPlace, slices.Equal(viaCopy, inPlace))
+}
+
+// h3 -- Generic Merge Function
+// h4 -- Merges sorted halves arr[:mid] and arr[mid:] through buf (same length as arr)
+// h6 -- Stable: ties are taken from the left half first
+func mergeWith[T any](arr, buf []T, mid int, less func(a, b T) bool) {
+	i, j, k := 0, mid, 0
+	for i < mid && j < len(arr) {
+		if less(arr[j], arr[i]) {
+			buf[k] = arr[j]
+			j++
+		} else {
+			buf[k] = arr[i]
+			i++
+		}
+		k++
+	}
+	k += copy(buf[k:], arr[i:mid])
+	copy(buf[k:], arr[j:])
+	copy(arr, buf)
+}
+
+// h3 -- Sequential Merge Sort Function
+// h4 -- Top-down recursive merge sort sharing one scratch buffer
+// h6 -- Time Complexity: O(n log n), Space Complexity: O(n)
+func sequentialMergeSort[T any](arr, buf []T, less func(a, b T) bool) {
+	if len(arr) < 2 {
+		return
+	}
+	mid := len(arr) / 2
+	sequentialMergeSort(arr[:mid], buf[:mid], less)
+	sequentialMergeSort(arr[mid:], buf[mid:], less)
+	mergeWith(arr, buf, mid, less)
+}
+
+// Subarrays shorter than this are sorted on the current goroutine; spawning
+// for tiny ranges costs more than it saves
+const parallelThreshold = 4096
+
+// h3 -- Parallel Merge Sort Function
+// h4 -- Sorts the two halves in separate goroutines down to a depth bound, then merges
+// h5 -- arr: Slice to sort in place
+// h5 -- less: Strict ordering; the sort is stable
+// h5 -- workers: Goroutine budget; recursion forks while 2^depth < workers (<= 1 means sequential)
+// h6 -- Time Complexity: O(n log n) work, Space Complexity: O(n) for one shared buffer
+// h6 -- Note: Sibling goroutines touch disjoint halves of arr and buf, so no locking is needed
+func parallelMergeSort[T any](arr []T, less func(a, b T) bool, workers int) {
+	depth := 0
+	for 1<<depth < workers {
+		depth++
+	}
+	buf := make([]T, len(arr))
+	parallelSort(arr, buf, less, depth)
+}
+
+func parallelSort[T any](arr, buf []T, less func(a, b T) bool, depth int) {
+	if depth == 0 || len(arr) < parallelThreshold {
+		sequentialMergeSort(arr, buf, less)
+		return
+	}
+	mid := len(arr) / 2
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		parallelSort(arr[:mid], buf[:mid], less, depth-1)
+	}()
+	parallelSort(arr[mid:], buf[mid:], less, depth-1)
+	wg.Wait()
+	mergeWith(arr, buf, mid, less)
+}
+
+// h3 -- Insertion Sort Helper
+// h4 -- Stable; fast on the short buckets produced by bucketSort
+// h6 -- Time Complexity: O(n²) worst case, O(n) on nearly sorted input
+func insertionSort(arr []float64) {
+	for i := 1; i < len(arr); i++ {
+		v := arr[i]
+		j := i
+		for j > 0 && arr[j-1] > v {
+			arr[j] = arr[j-1]
+			j--
+		}
+		arr[j] = v
+	}
+}
+
+// h3 -- Bucket Sort Function
+// h4 -- Scatters values into equal-width buckets over [min, max], sorts each, concatenates
+// h5 -- arr: Slice to sort in place; panics on NaN or ±Inf, which have no bucket
+// h5 -- buckets: Number of buckets; values <= 0 default to len(arr)
+// h6 -- Time Complexity: O(n + buckets) expected on uniform data, O(n²) if everything lands in one bucket
+// h6 -- Space Complexity: O(n + buckets)
+// h6 -- Note: Scaling to the observed range means input need not lie in [0, 1); the
+// h6 --       range is measured on halved values, since hi - lo can exceed MaxFloat64
+func bucketSort(arr []float64, buckets int) {
+	n := len(arr)
+	if n < 2 {
+		return
+	}
+	if buckets <= 0 {
+		buckets = n
+	}
+
+	lo, hi := arr[0], arr[0]
+	for _, v := range arr {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			panic(fmt.Sprintf("bucketSort: cannot bucket non-finite value %v", v))
+		}
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	if lo == hi {
+		return // All values equal
+	}
+
+	bins := make([][]float64, buckets)
+	width := hi/2 - lo/2 // Finite even when hi - lo would overflow to +Inf
+	for _, v := range arr {
+		b := int(float64(buckets) * ((v/2 - lo/2) / width))
+		b = min(max(b, 0), buckets-1) // v == hi maps to the last bucket; rounding may stray
+		bins[b] = append(bins[b], v)
+	}
+
+	i := 0
+	for _, bin := range bins {
+		insertionSort(bin)
+		i += copy(arr[i:], bin)
+	}
+}
+
+// h3 -- Deterministic Selection Function (Median of Medians)
+// h4 -- Finds the k-th smallest element with a pivot guaranteed to split off 30% of the input
+// h5 -- arr: Input slice (left unmodified)
+// h5 -- k: 0-based rank; panics if out of range
+// h6 -- Time Complexity: O(n) worst case, Space Complexity: O(n)
+// h6 -- Note: Randomized quickselect is faster on average but O(n²) on adversarial input
+func selectKthDeterministic(arr []int, k int) int {
+	value, _ := selectKthInstrumented(arr, k)
+	return value
+}
+
+// h3 -- Instrumented Selection
+// h4 -- Same as selectKthDeterministic, also reporting the deepest recursion reached
+func selectKthInstrumented(arr []int, k int) (int, int) {
+	if k < 0 || k >= len(arr) {
+		panic(fmt.Sprintf("selectKthDeterministic: rank %d out of range [0, %d)", k, len(arr)))
+	}
+	maxDepth := 0
+	value := momSelect(slices.Clone(arr), k, 1, &maxDepth)
+	return value, maxDepth
+}
+
+// h3 -- Small Sort Helper
+// h4 -- Insertion sort for groups of at most five elements
+func sortSmall(arr []int) {
+	for i := 1; i < len(arr); i++ {
+		for j := i; j > 0 && arr[j-1] > arr[j]; j-- {
+			arr[j-1], arr[j] = arr[j], arr[j-1]
+		}
+	}
+}
+
+func momSelect(arr []int, k, depth int, maxDepth *int) int {
+	*maxDepth = max(*maxDepth, depth)
+	if len(arr) <= 5 {
+		sortSmall(arr)
+		return arr[k]
+	}
+
+	// Median of each group of five, then the median of those medians as pivot
+	medians := make([]int, 0, (len(arr)+4)/5)
+	for lo := 0; lo < len(arr); lo += 5 {
+		group := arr[lo:min(lo+5, len(arr))]
+		sortSmall(group)
+		medians = append(medians, group[len(group)/2])
+	}
+	pivot := momSelect(medians, len(medians)/2, depth+1, maxDepth)
+
+	// Three-way partition: [0,lt) < pivot, [lt,gt) == pivot, [gt,n) > pivot
+	lt, i, gt := 0, 0, len(arr)
+	for i < gt {
+		switch {
+		case arr[i] < pivot:
+			arr[lt], arr[i] = arr[i], arr[lt]
+			lt++
+			i++
+		case arr[i] > pivot:
+			gt--
+			arr[gt], arr[i] = arr[i], arr[gt]
+		default:
+			i++
+		}
+	}
+
+	switch {
+	case k < lt:
+		return momSelect(arr[:lt], k, depth+1, maxDepth)
+	case k >= gt:
+		return momSelect(arr[gt:], k-gt, depth+1, maxDepth)
+	default:
+		return pivot
+	}
+}
+
+func main() {
+	fmt.Println("=== SORTING ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- In-Place Merge Validation
+	// h4 -- Checks the rotation merge against the buffered merge
+	fmt.Println("1. IN-PLACE MERGE")
+	fmt.Println("=================")
+	compareMerges("equal halves", []int{1, 4, 7, 9, 2, 3, 8, 10}, 0, 4, 8)
+	compareMerges("unequal regions", []int{5, 6, 1, 2, 3, 4, 7}, 0, 2, 7)
+	compareMerges("already merged", []int{1, 2, 3, 4, 5, 6}, 0, 3, 6)
+	compareMerges("duplicates", []int{2, 2, 5, 1, 2, 5}, 0, 3, 6)
+	compareMerges("empty right", []int{3, 1, 2}, 1, 3, 3)
+
+	// h3 -- Merge Sort With Both Strategies
+	// h4 -- Sorts the same random slice with each merge and checks the result
+	fmt.Println("\n2. MERGE SORT")
+	fmt.Println("=============")
+	rng := rand.New(rand.NewSource(1))
+	data := make([]int, 1000)
+	for i := range data {
+		data[i] = rng.Intn(100)
+	}
+
+	viaCopy := slices.Clone(data)
+	mergeSort(viaCopy, mergeCopy)
+	inPlace := slices.Clone(data)
+	mergeSort(inPlace, mergeInPlace)
+	fmt.Printf("  Copy merge sorted:     %t\n", slices.IsSorted(viaCopy))
+	fmt.Printf("  In-place merge sorted: %t\n", slices.IsSorted(inPlace))
+	fmt.Printf("  Results identical:     %t\n", slices.Equal(viaCopy, inPlace))
+
+	// h3 -- Parallel Merge Sort
+	// h4 -- Speedup over the sequential version on a million elements, plus stability
+	fmt.Println("\n3. PARALLEL MERGE SORT")
+	fmt.Println("======================")
+	const size = 1_000_000
+	large := make([]int, size)
+	for i := range large {
+		large[i] = rng.Int()
+	}
+	less := func(a, b int) bool { return a < b }
+
+	sequential := slices.Clone(large)
+	start := time.Now()
+	sequentialMergeSort(sequential, make([]int, size), less)
+	seqTime := time.Since(start)
+	fmt.Printf("  %d elements, GOMAXPROCS=%d\n", size, runtime.GOMAXPROCS(0))
+	fmt.Printf("  Sequential:          %v\n", seqTime)
+
+	for _, w := range []int{2, 4, 8} {
+		parallel := slices.Clone(large)
+		start = time.Now()
+		parallelMergeSort(parallel, less, w)
+		parTime := time.Since(start)
+		fmt.Printf("  Parallel, %d workers: %v (speedup %.2fx, matches: %t)\n",
+			w, parTime, seqTime.Seconds()/parTime.Seconds(), slices.Equal(sequential, parallel))
+	}
+
+	// Sort pairs by key only; equal keys must keep their original order
+	type pair struct{ key, seq int }
+	pairs := make([]pair, 100_000)
+	for i := range pairs {
+		pairs[i] = pair{rng.Intn(100), i}
+	}
+	parallelMergeSort(pairs, func(a, b pair) bool { return a.key < b.key }, 8)
+	stable := slices.IsSortedFunc(pairs, func(a, b pair) int {
+		if a.key != b.key {
+			return a.key - b.key
+		}
+		return a.seq - b.seq
+	})
+	fmt.Printf("  Stable on %d keyed pairs: %t\n", len(pairs), stable)
+	fmt.Println("  One-shot timings are noisy; for repeatable numbers and a race check run:")
+	fmt.Println("  go test -race main.go main_test.go && go test -bench=ParallelMergeSort main.go main_test.go")
+
+	// h3 -- Bucket Sort
+	// h4 -- Uniform, clustered, out-of-[0,1) and near-MaxFloat64 inputs checked against sort.Float64s
+	fmt.Println("\n4. BUCKET SORT")
+	fmt.Println("==============")
+	inputs := []struct {
+		name string
+		gen  func() float64
+	}{
+		{"uniform [0,1)", rng.Float64},
+		{"clustered", func() float64 { return float64(rng.Intn(3))*10 + rng.NormFloat64()*0.01 }},
+		{"wide range", func() float64 { return (rng.Float64() - 0.5) * 1e6 }},
+		{"few distinct", func() float64 { return float64(rng.Intn(4)) }},
+		{"extreme range", func() float64 { return (2*rng.Float64() - 1) * math.MaxFloat64 }}, // hi - lo overflows
+	}
+	for _, in := range inputs {
+		values := make([]float64, 100_000)
+		for i := range values {
+			values[i] = in.gen()
+		}
+		expected := slices.Clone(values)
+		start := time.Now()
+		sort.Float64s(expected)
+		libTime := time.Since(start)
+
+		start = time.Now()
+		bucketSort(values, 0)
+		bucketTime := time.Since(start)
+		fmt.Printf("  %-14s bucket: %-12v sort.Float64s: %-12v matches: %t\n",
+			in.name, bucketTime, libTime, slices.Equal(values, expected))
+	}
+	extremes := []float64{1e308, -1e308, 0, -math.MaxFloat64, math.MaxFloat64}
+	bucketSort(extremes, 0)
+	fmt.Printf("  Range wider than MaxFloat64: %v\n", extremes)
+
+	// h3 -- Deterministic Selection
+	// h4 -- Agreement with sorting, and recursion depth on sorted (adversarial) input
+	fmt.Println("\n5. MEDIAN-OF-MEDIANS SELECTION")
+	fmt.Println("==============================")
+
+	mismatches := 0
+	const trials = 300
+	for t := 0; t < trials; t++ {
+		values := make([]int, 1+rng.Intn(200))
+		for i := range values {
+			values[i] = rng.Intn(50)
+		}
+		sorted := slices.Clone(values)
+		slices.Sort(sorted)
+		k := rng.Intn(len(values))
+		if selectKthDeterministic(values, k) != sorted[k] {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random trials: %d, mismatches against sorting: %d\n", trials, mismatches)
+
+	for _, n := range []int{1_000, 100_000, 1_000_000} {
+		ascending := make([]int, n)
+		for i := range ascending {
+			ascending[i] = i
+		}
+		start := time.Now()
+		median, depth := selectKthInstrumented(ascending, n/2)
+		fmt.Printf("  Sorted input n=%-8d median %-7d max depth %2d  %v\n", n, median, depth, time.Since(start))
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+// item carries its original position so the tests can tell whether equal
+// keys kept their input order.
+type item struct{ key, pos int }
+
+func TestParallelMergeSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	inputs := map[string]func(n int) []int{
+		"random": func(n int) []int { return rng.Perm(n) },
+		"sorted": func(n int) []int {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = i
+			}
+			return arr
+		},
+		"reversed": func(n int) []int {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = n - i
+			}
+			return arr
+		},
+		"duplicate-heavy": func(n int) []int {
+			arr := make([]int, n)
+			for i := range arr {
+				arr[i] = rng.Intn(8)
+			}
+			return arr
+		},
+	}
+	less := func(a, b item) bool { return a.key < b.key }
+	byKey := func(a, b item) int { return cmp.Compare(a.key, b.key) }
+
+	// Sizes above parallelThreshold make the recursion actually fork
+	for _, n := range []int{0, 1, 2, 1000, parallelThreshold + 1, 50_000} {
+		for name, gen := range inputs {
+			keys := gen(n)
+			for _, workers := range []int{-1, 0, 1, 2, 3, 8, 64} {
+				t.Run(fmt.Sprintf("%s/n=%d/workers=%d", name, n, workers), func(t *testing.T) {
+					items := make([]item, n)
+					for i, k := range keys {
+						items[i] = item{k, i}
+					}
+					want := slices.Clone(items)
+					slices.SortStableFunc(want, byKey)
+
+					parallelMergeSort(items, less, workers)
+					if !slices.Equal(items, want) {
+						t.Error("result differs from slices.SortStableFunc")
+					}
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkParallelMergeSort(b *testing.B) {
+	const n = 1_000_000
+	values := rand.New(rand.NewSource(1)).Perm(n)
+	less := func(a, b int) bool { return a < b }
+	arr := make([]int, n)
+
+	b.Run("sequential", func(b *testing.B) {
+		buf := make([]int, n)
+		for i := 0; i < b.N; i++ {
+			copy(arr, values)
+			sequentialMergeSort(arr, buf, less)
+		}
+	})
+	for _, workers := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				copy(arr, values)
+				parallelMergeSort(arr, less, workers)
+			}
+		})
+	}
+}
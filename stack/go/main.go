@@ -0,0 +1,646 @@
+// h1 -- Stack Implementation in Go
+// h2 -- Generic LIFO stack backed by a slice
+// h2 -- Includes a small calculator built from postfix evaluation and shunting-yard
+// h2 -- Monotonic stacks solve next-greater-element and histogram rectangle problems
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// h3 -- Stack Type
+// h4 -- The top of the stack is the end of the slice, so push/pop are amortized O(1)
+type Stack[T any] struct {
+	items []T
+}
+
+// h3 -- Stack Operations
+// h4 -- Push adds to the top; Pop and Peek report false on an empty stack
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	v := s.items[len(s.items)-1]
+	s.items[len(s.items)-1] = zero // Release the reference
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *Stack[T]) Len() int      { return len(s.items) }
+func (s *Stack[T]) IsEmpty() bool { return len(s.items) == 0 }
+
+// h3 -- Remove Bottom Function
+// h4 -- Removes the oldest element; used when sub-stacks shift left
+// h6 -- Time Complexity: O(n) - remaining elements move down one slot
+func (s *Stack[T]) removeBottom() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	v := s.items[0]
+	copy(s.items, s.items[1:])
+	s.items[len(s.items)-1] = zero
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// h3 -- Calculator Errors
+// h4 -- Sentinel errors so callers can distinguish failure kinds with errors.Is
+var (
+	errMalformed      = errors.New("malformed expression")
+	errDivisionByZero = errors.New("division by zero")
+)
+
+// h3 -- Operator Table
+// h4 -- Precedence for each binary operator; '^' is the only right-associative one
+var precedence = map[string]int{"+": 1, "-": 1, "*": 2, "/": 2, "^": 3}
+
+func isOperator(token string) bool {
+	_, ok := precedence[token]
+	return ok
+}
+
+// h3 -- Apply Operator Function
+// h4 -- Computes a op b, rejecting division by zero
+func applyOperator(op string, a, b float64) (float64, error) {
+	switch op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return 0, errDivisionByZero
+		}
+		return a / b, nil
+	case "^":
+		return math.Pow(a, b), nil
+	}
+	return 0, fmt.Errorf("%w: unknown operator %q", errMalformed, op)
+}
+
+// h3 -- Postfix Evaluation Function
+// h4 -- Evaluates a space-separated reverse-Polish expression with an operand stack
+// h5 -- expr: Tokens such as "3 4 2 * +"
+// h6 -- Returns: Result, or an error for malformed input or division by zero
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func evalPostfix(expr string) (float64, error) {
+	var operands Stack[float64]
+
+	for _, token := range strings.Fields(expr) {
+		if !isOperator(token) {
+			v, err := strconv.ParseFloat(token, 64)
+			if err != nil {
+				return 0, fmt.Errorf("%w: invalid token %q", errMalformed, token)
+			}
+			operands.Push(v)
+			continue
+		}
+
+		// Right operand is on top, left operand beneath it
+		b, okB := operands.Pop()
+		a, okA := operands.Pop()
+		if !okA || !okB {
+			return 0, fmt.Errorf("%w: operator %q is missing an operand", errMalformed, token)
+		}
+		result, err := applyOperator(token, a, b)
+		if err != nil {
+			return 0, err
+		}
+		operands.Push(result)
+	}
+
+	if operands.Len() != 1 {
+		return 0, fmt.Errorf("%w: expected one result, found %d values", errMalformed, operands.Len())
+	}
+	result, _ := operands.Pop()
+	return result, nil
+}
+
+// h3 -- Tokenizer Function
+// h4 -- Splits an infix expression into numbers, operators and parentheses
+// h6 -- Spaces between tokens are optional
+func tokenize(expr string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')' || isOperator(string(c)):
+			tokens = append(tokens, string(c))
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			start := i
+			for i < len(expr) && (expr[i] >= '0' && expr[i] <= '9' || expr[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, expr[start:i])
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q", errMalformed, c)
+		}
+	}
+	return tokens, nil
+}
+
+// h3 -- Infix To Postfix Function
+// h4 -- Shunting-yard: operators wait on a stack until a lower-precedence operator arrives
+// h5 -- expr: Infix expression such as "3 + 4 * (2 - 1)"
+// h6 -- Returns: Space-separated postfix expression, or an error for malformed input
+// h6 -- Time Complexity: O(n), Space Complexity: O(n)
+func infixToPostfix(expr string) (string, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return "", err
+	}
+
+	var output []string
+	var operators Stack[string]
+	expectOperand := true // Alternation check catches "3 +" and "3 4"
+
+	for _, token := range tokens {
+		switch {
+		case token == "(":
+			if !expectOperand {
+				return "", fmt.Errorf("%w: unexpected '('", errMalformed)
+			}
+			operators.Push(token)
+
+		case token == ")":
+			if expectOperand {
+				return "", fmt.Errorf("%w: unexpected ')'", errMalformed)
+			}
+			for {
+				top, ok := operators.Pop()
+				if !ok {
+					return "", fmt.Errorf("%w: unmatched ')'", errMalformed)
+				}
+				if top == "(" {
+					break
+				}
+				output = append(output, top)
+			}
+
+		case isOperator(token):
+			if expectOperand {
+				return "", fmt.Errorf("%w: operator %q is missing an operand", errMalformed, token)
+			}
+			for {
+				top, ok := operators.Peek()
+				if !ok || top == "(" {
+					break
+				}
+				// Pop higher precedence, or equal precedence for left-associative operators
+				if precedence[top] > precedence[token] ||
+					precedence[top] == precedence[token] && token != "^" {
+					operators.Pop()
+					output = append(output, top)
+					continue
+				}
+				break
+			}
+			operators.Push(token)
+			expectOperand = true
+
+		default:
+			if !expectOperand {
+				return "", fmt.Errorf("%w: missing operator before %q", errMalformed, token)
+			}
+			if _, err := strconv.ParseFloat(token, 64); err != nil {
+				return "", fmt.Errorf("%w: invalid number %q", errMalformed, token)
+			}
+			output = append(output, token)
+			expectOperand = false
+		}
+	}
+
+	if expectOperand {
+		return "", fmt.Errorf("%w: expression ends without an operand", errMalformed)
+	}
+	for !operators.IsEmpty() {
+		top, _ := operators.Pop()
+		if top == "(" {
+			return "", fmt.Errorf("%w: unmatched '('", errMalformed)
+		}
+		output = append(output, top)
+	}
+	return strings.Join(output, " "), nil
+}
+
+// h3 -- Calculator Function
+// h4 -- Converts infix to postfix, then evaluates it
+func calculate(expr string) (float64, error) {
+	postfix, err := infixToPostfix(expr)
+	if err != nil {
+		return 0, err
+	}
+	return evalPostfix(postfix)
+}
+
+// h3 -- Next Greater Element Function
+// h4 -- Keeps a stack of indices whose values decrease from bottom to top
+// h5 -- arr: Input values
+// h6 -- Returns: For each index, the next strictly greater value to its right, or -1
+// h6 -- Time Complexity: O(n) - each index is pushed and popped at most once
+func nextGreaterElements(arr []int) []int {
+	result := make([]int, len(arr))
+	var pending Stack[int] // Indices still waiting for a greater element
+
+	for i, v := range arr {
+		for {
+			top, ok := pending.Peek()
+			if !ok || arr[top] >= v {
+				break
+			}
+			pending.Pop()
+			result[top] = v // v is the first greater value after top
+		}
+		pending.Push(i)
+	}
+
+	for !pending.IsEmpty() {
+		top, _ := pending.Pop()
+		result[top] = -1
+	}
+	return result
+}
+
+// h3 -- Circular Next Greater Element Function
+// h4 -- Same as nextGreaterElements, but the search wraps past the end to the start
+// h6 -- Implemented by scanning the indices twice; only the first pass pushes
+// h6 -- Time Complexity: O(n)
+func nextGreaterElementsCircular(arr []int) []int {
+	n := len(arr)
+	result := make([]int, n)
+	for i := range result {
+		result[i] = -1
+	}
+
+	var pending Stack[int]
+	for i := 0; i < 2*n; i++ {
+		v := arr[i%n]
+		for {
+			top, ok := pending.Peek()
+			if !ok || arr[top] >= v {
+				break
+			}
+			pending.Pop()
+			result[top] = v
+		}
+		if i < n {
+			pending.Push(i)
+		}
+	}
+	return result
+}
+
+// h3 -- Largest Rectangle In Histogram Function
+// h4 -- Keeps a stack of bar indices whose heights increase from bottom to top
+// h5 -- heights: Bar heights, each bar one unit wide
+// h6 -- When a shorter bar arrives, each popped bar is the shortest of a rectangle
+// h6 -- spanning from just after the new stack top up to (not including) the current bar
+// h6 -- Returns: Area of the largest rectangle that fits under the histogram
+// h6 -- Time Complexity: O(n) - each index is pushed and popped at most once
+func largestRectangleInHistogram(heights []int) int {
+	best := 0
+	var rising Stack[int] // Indices of bars with increasing heights
+
+	for i := 0; i <= len(heights); i++ {
+		h := 0 // A zero-height sentinel past the end flushes the stack
+		if i < len(heights) {
+			h = heights[i]
+		}
+		for {
+			top, ok := rising.Peek()
+			if !ok || heights[top] < h {
+				break
+			}
+			rising.Pop()
+			left := -1 // The bar below top on the stack is the nearest shorter one
+			if below, ok := rising.Peek(); ok {
+				left = below
+			}
+			best = max(best, heights[top]*(i-left-1))
+		}
+		rising.Push(i)
+	}
+	return best
+}
+
+// h3 -- Brute-Force Largest Rectangle Function
+// h4 -- Reference implementation that tries every [i, j] span with its minimum height
+// h6 -- Time Complexity: O(n²)
+func bruteLargestRectangle(heights []int) int {
+	best := 0
+	for i := range heights {
+		lowest := heights[i]
+		for j := i; j < len(heights); j++ {
+			lowest = min(lowest, heights[j])
+			best = max(best, lowest*(j-i+1))
+		}
+	}
+	return best
+}
+
+// h3 -- Maximal Rectangle Function
+// h4 -- Largest all-ones rectangle in a binary matrix
+// h5 -- heights: Run of consecutive ones ending at the current row, per column
+// h6 -- Each row is the base of a histogram, so the answer is the best histogram rectangle
+// h6 -- Panics if the rows differ in length
+// h6 -- Time Complexity: O(rows * cols), Space Complexity: O(cols)
+func maximalRectangle(matrix [][]int) int {
+	if len(matrix) == 0 {
+		return 0
+	}
+	for i, row := range matrix {
+		if len(row) != len(matrix[0]) {
+			panic(fmt.Sprintf("maximalRectangle: row %d has %d columns, want %d", i, len(row), len(matrix[0])))
+		}
+	}
+	best := 0
+	heights := make([]int, len(matrix[0]))
+	for _, row := range matrix {
+		for c, cell := range row {
+			if cell == 0 {
+				heights[c] = 0 // A zero breaks every column run through it
+			} else {
+				heights[c]++
+			}
+		}
+		best = max(best, largestRectangleInHistogram(heights))
+	}
+	return best
+}
+
+// h3 -- Brute-Force Maximal Rectangle Function
+// h4 -- Reference implementation that checks every rectangle by its corners
+// h6 -- Time Complexity: O((rows * cols)³)
+func bruteMaximalRectangle(matrix [][]int) int {
+	best := 0
+	for r1 := range matrix {
+		for c1 := range matrix[r1] {
+			for r2 := r1; r2 < len(matrix); r2++ {
+				for c2 := c1; c2 < len(matrix[r2]); c2++ {
+					allOnes := true
+					for r := r1; r <= r2 && allOnes; r++ {
+						for c := c1; c <= c2 && allOnes; c++ {
+							allOnes = matrix[r][c] == 1
+						}
+					}
+					if allOnes {
+						best = max(best, (r2-r1+1)*(c2-c1+1))
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+// h3 -- Set Of Stacks Type
+// h4 -- Behaves like one stack but starts a new sub-stack every capacity elements
+// h6 -- Every sub-stack except the last is kept full
+type SetOfStacks[T any] struct {
+	capacity int
+	stacks   []*Stack[T]
+}
+
+// h3 -- Set Of Stacks Constructor
+// h6 -- Panics: when capacity is not positive
+func NewSetOfStacks[T any](capacity int) *SetOfStacks[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("SetOfStacks: capacity must be positive, got %d", capacity))
+	}
+	return &SetOfStacks[T]{capacity: capacity}
+}
+
+// h3 -- Push Function
+// h4 -- Pushes onto the last sub-stack, rolling over when it is full
+func (s *SetOfStacks[T]) Push(v T) {
+	if len(s.stacks) == 0 || s.stacks[len(s.stacks)-1].Len() == s.capacity {
+		s.stacks = append(s.stacks, &Stack[T]{})
+	}
+	s.stacks[len(s.stacks)-1].Push(v)
+}
+
+// h3 -- Pop Function
+// h4 -- Pops the overall top element, discarding the last sub-stack once empty
+func (s *SetOfStacks[T]) Pop() (T, bool) {
+	return s.PopAt(len(s.stacks) - 1)
+}
+
+// h3 -- Pop At Function
+// h4 -- Pops from sub-stack index, then shifts later elements left to refill it
+// h5 -- index: Sub-stack to pop from (0 is the oldest)
+// h6 -- Returns: Popped element and true, or false for an invalid index
+// h6 -- Time Complexity: O(n) - each later sub-stack passes its bottom element left
+func (s *SetOfStacks[T]) PopAt(index int) (T, bool) {
+	if index < 0 || index >= len(s.stacks) {
+		var zero T
+		return zero, false
+	}
+	v, _ := s.stacks[index].Pop()
+
+	// Pull each following sub-stack's bottom onto the previous sub-stack's top
+	for i := index + 1; i < len(s.stacks); i++ {
+		bottom, _ := s.stacks[i].removeBottom()
+		s.stacks[i-1].Push(bottom)
+	}
+
+	last := len(s.stacks) - 1
+	if s.stacks[last].IsEmpty() {
+		s.stacks[last] = nil
+		s.stacks = s.stacks[:last]
+	}
+	return v, true
+}
+
+// h3 -- Size Accessors
+// h4 -- Len counts all elements; NumStacks counts sub-stacks in use
+func (s *SetOfStacks[T]) Len() int {
+	total := 0
+	for _, st := range s.stacks {
+		total += st.Len()
+	}
+	return total
+}
+
+func (s *SetOfStacks[T]) NumStacks() int { return len(s.stacks) }
+
+func main() {
+	fmt.Println("=== STACK - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Basic Functionality Test
+	// h4 -- Pushes values and pops them back in LIFO order
+	fmt.Println("1. BASIC FUNCTIONALITY TEST")
+	fmt.Println("===========================")
+	var s Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	top, _ := s.Peek()
+	fmt.Printf("Peek: %d (size %d)\n", top, s.Len())
+	for !s.IsEmpty() {
+		v, _ := s.Pop()
+		fmt.Printf("Pop: %d\n", v)
+	}
+	_, ok := s.Pop()
+	fmt.Printf("Pop on empty stack succeeds: %t\n", ok)
+
+	// h3 -- Expression Calculator
+	// h4 -- Exercises precedence, associativity, parentheses and error handling
+	fmt.Println("\n2. EXPRESSION CALCULATOR")
+	fmt.Println("========================")
+	expressions := []string{
+		"3 + 4 * 2",
+		"(3 + 4) * 2",
+		"2 ^ 3 ^ 2",
+		"10 - 4 - 3",
+		"7 / (2 - 2)",
+		"3 + * 4",
+		"(1 + 2",
+	}
+	for _, expr := range expressions {
+		postfix, err := infixToPostfix(expr)
+		if err != nil {
+			fmt.Printf("  %-12s -> error: %v\n", expr, err)
+			continue
+		}
+		result, err := calculate(expr)
+		if err != nil {
+			fmt.Printf("  %-12s -> %-13s = error: %v\n", expr, postfix, err)
+			continue
+		}
+		fmt.Printf("  %-12s -> %-13s = %g\n", expr, postfix, result)
+	}
+
+	// h3 -- Next Greater Element
+	// h4 -- Monotonic stack on linear and circular inputs
+	fmt.Println("\n3. NEXT GREATER ELEMENT")
+	fmt.Println("=======================")
+	for _, arr := range [][]int{
+		{5, 4, 3, 2, 1},
+		{1, 2, 3, 4, 5},
+		{2, 7, 3, 5, 4, 6, 8},
+		{1, 2, 1},
+	} {
+		fmt.Printf("  %v\n    linear:   %v\n    circular: %v\n",
+			arr, nextGreaterElements(arr), nextGreaterElementsCircular(arr))
+	}
+
+	// h3 -- Set Of Stacks
+	// h4 -- LIFO order across sub-stacks, and PopAt shifting elements left
+	fmt.Println("\n4. SET OF STACKS")
+	fmt.Println("================")
+
+	plates := NewSetOfStacks[int](3)
+	for i := 1; i <= 8; i++ {
+		plates.Push(i)
+	}
+	fmt.Printf("  Pushed 1..8 with capacity 3: %d sub-stacks\n", plates.NumStacks())
+	middle, _ := plates.PopAt(1)
+	fmt.Printf("  PopAt(1): %d, sub-stacks now %d\n", middle, plates.NumStacks())
+	fmt.Print("  Remaining pops:")
+	for plates.Len() > 0 {
+		v, _ := plates.Pop()
+		fmt.Printf(" %d", v)
+	}
+	fmt.Println()
+
+	// h3 -- Largest Rectangle In Histogram
+	// h4 -- Monotonic shapes and plateaus, then random histograms against brute force
+	fmt.Println("\n5. LARGEST RECTANGLE IN HISTOGRAM")
+	fmt.Println("=================================")
+	for _, heights := range [][]int{
+		{1, 2, 3, 4, 5}, // Strictly increasing
+		{5, 4, 3, 2, 1}, // Strictly decreasing
+		{3, 3, 3, 3},    // Plateau
+		{2, 1, 5, 6, 2, 3},
+		{2, 4, 4, 4, 1, 4},
+		{},
+	} {
+		fmt.Printf("  %v: %d (brute force: %d)\n",
+			heights, largestRectangleInHistogram(heights), bruteLargestRectangle(heights))
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	mismatches := 0
+	const trials = 500
+	for t := 0; t < trials; t++ {
+		heights := make([]int, rng.Intn(20))
+		for i := range heights {
+			heights[i] = rng.Intn(6) // Small range, so equal heights are frequent
+		}
+		if largestRectangleInHistogram(heights) != bruteLargestRectangle(heights) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random histograms vs brute force: %d trials, mismatches: %d\n", trials, mismatches)
+
+	// h3 -- Maximal Rectangle
+	// h4 -- Each matrix row becomes a histogram base for largestRectangleInHistogram
+	fmt.Println("\n6. MAXIMAL RECTANGLE IN BINARY MATRIX")
+	fmt.Println("=====================================")
+	matrices := []struct {
+		name   string
+		matrix [][]int
+	}{
+		{"all ones", [][]int{{1, 1, 1}, {1, 1, 1}}},
+		{"all zeros", [][]int{{0, 0}, {0, 0}, {0, 0}}},
+		{"mixed", [][]int{
+			{1, 0, 1, 0, 0},
+			{1, 0, 1, 1, 1},
+			{1, 1, 1, 1, 1},
+			{1, 0, 0, 1, 0},
+		}}, // Best is rows 1-2, columns 2-4
+		{"empty", nil},
+	}
+	for _, m := range matrices {
+		fmt.Printf("  %s: %d (brute force: %d)\n",
+			m.name, maximalRectangle(m.matrix), bruteMaximalRectangle(m.matrix))
+	}
+	func() {
+		defer func() { fmt.Printf("  jagged: %v\n", recover()) }()
+		maximalRectangle([][]int{{1, 1, 1}, {1}})
+	}()
+
+	mismatches = 0
+	for t := 0; t < trials; t++ {
+		matrix := make([][]int, 1+rng.Intn(6))
+		cols := 1 + rng.Intn(6)
+		for r := range matrix {
+			matrix[r] = make([]int, cols)
+			for c := range matrix[r] {
+				if rng.Intn(4) != 0 { // Mostly ones, so rectangles span several rows
+					matrix[r][c] = 1
+				}
+			}
+		}
+		if maximalRectangle(matrix) != bruteMaximalRectangle(matrix) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Random matrices vs brute force: %d trials, mismatches: %d\n", trials, mismatches)
+}
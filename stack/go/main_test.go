@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestCalculate(t *testing.T) {
+	tests := []struct {
+		expr string
+		want float64
+	}{
+		{"3 + 4 * 2", 11},   // * binds tighter than +
+		{"(3 + 4) * 2", 14}, // Parentheses override precedence
+		{"2 ^ 3 ^ 2", 512},  // ^ is right-associative
+		{"10 - 4 - 3", 3},   // - is left-associative
+		{"100 / 10 / 5", 2},
+		{"2 * (3 + (4 - 1)) ^ 2", 72},
+		{"((7))", 7},
+		{"1.5 * 4", 6},
+	}
+	for _, tt := range tests {
+		got, err := calculate(tt.expr)
+		if err != nil || got != tt.want {
+			t.Errorf("calculate(%q) = %g, %v, want %g", tt.expr, got, err, tt.want)
+		}
+	}
+}
+
+func TestCalculateErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want error
+	}{
+		{"7 / (2 - 2)", errDivisionByZero},
+		{"1 / 0", errDivisionByZero},
+		{"3 + * 4", errMalformed},
+		{"(1 + 2", errMalformed},
+		{"1 + 2)", errMalformed},
+		{"()", errMalformed},
+		{"4 5", errMalformed},
+		{"2 +", errMalformed},
+		{"2 $ 3", errMalformed},
+		{"", errMalformed},
+	}
+	for _, tt := range tests {
+		got, err := calculate(tt.expr)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("calculate(%q) = %g, %v, want an error wrapping %v", tt.expr, got, err, tt.want)
+		}
+	}
+	if errors.Is(errMalformed, errDivisionByZero) {
+		t.Error("error kinds are not distinguishable")
+	}
+}
+
+func TestEvalPostfix(t *testing.T) {
+	if got, err := evalPostfix("3 4 2 * +"); err != nil || got != 11 {
+		t.Errorf(`evalPostfix("3 4 2 * +") = %g, %v, want 11`, got, err)
+	}
+	for expr, want := range map[string]error{
+		"1 0 /": errDivisionByZero,
+		"1 +":   errMalformed,
+		"1 2":   errMalformed,
+		"1 x +": errMalformed,
+	} {
+		if _, err := evalPostfix(expr); !errors.Is(err, want) {
+			t.Errorf("evalPostfix(%q) error = %v, want %v", expr, err, want)
+		}
+	}
+}
+
+// bruteNextGreater scans right of each index, wrapping around when circular.
+func bruteNextGreater(arr []int, circular bool) []int {
+	n := len(arr)
+	result := make([]int, n)
+	for i := range arr {
+		result[i] = -1
+		limit := n - i - 1
+		if circular {
+			limit = n - 1
+		}
+		for d := 1; d <= limit; d++ {
+			if v := arr[(i+d)%n]; v > arr[i] {
+				result[i] = v
+				break
+			}
+		}
+	}
+	return result
+}
+
+func TestNextGreaterElements(t *testing.T) {
+	if got := nextGreaterElements([]int{2, 7, 3, 5, 4, 6, 8}); !slices.Equal(got, []int{7, 8, 5, 6, 6, 8, -1}) {
+		t.Errorf("linear = %v", got)
+	}
+	if got := nextGreaterElementsCircular([]int{1, 2, 1}); !slices.Equal(got, []int{2, -1, 2}) {
+		t.Errorf("circular = %v", got)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 500; trial++ {
+		arr := make([]int, rng.Intn(15))
+		for i := range arr {
+			arr[i] = rng.Intn(6) // Small range, so ties are frequent
+		}
+		if got, want := nextGreaterElements(arr), bruteNextGreater(arr, false); !slices.Equal(got, want) {
+			t.Fatalf("nextGreaterElements(%v) = %v, want %v", arr, got, want)
+		}
+		if got, want := nextGreaterElementsCircular(arr), bruteNextGreater(arr, true); !slices.Equal(got, want) {
+			t.Fatalf("nextGreaterElementsCircular(%v) = %v, want %v", arr, got, want)
+		}
+	}
+}
+
+func TestSetOfStacks(t *testing.T) {
+	plates := NewSetOfStacks[int](3)
+	for i := 1; i <= 8; i++ {
+		plates.Push(i)
+	}
+	if plates.NumStacks() != 3 || plates.Len() != 8 {
+		t.Fatalf("after 8 pushes: %d sub-stacks, %d elements, want 3 and 8", plates.NumStacks(), plates.Len())
+	}
+	if v, ok := plates.PopAt(1); !ok || v != 6 {
+		t.Errorf("PopAt(1) = %d, %t, want 6", v, ok)
+	}
+	if plates.NumStacks() != 3 {
+		t.Errorf("after PopAt: %d sub-stacks, want 3", plates.NumStacks())
+	}
+	if _, ok := plates.PopAt(3); ok {
+		t.Error("PopAt past the last sub-stack succeeded")
+	}
+
+	var rest []int
+	for plates.Len() > 0 {
+		v, _ := plates.Pop()
+		rest = append(rest, v)
+	}
+	// 7 moved down into the middle sub-stack to refill it, and 8 behind it
+	if want := []int{8, 7, 5, 4, 3, 2, 1}; !slices.Equal(rest, want) {
+		t.Errorf("remaining pops = %v, want %v", rest, want)
+	}
+	if _, ok := plates.Pop(); ok || plates.NumStacks() != 0 {
+		t.Error("Pop on an empty set succeeded or left sub-stacks behind")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewSetOfStacks(0) did not panic")
+		}
+	}()
+	NewSetOfStacks[int](0)
+}
+
+func TestLargestRectangleInHistogram(t *testing.T) {
+	tests := []struct {
+		heights []int
+		want    int
+	}{
+		{[]int{1, 2, 3, 4, 5}, 9},
+		{[]int{5, 4, 3, 2, 1}, 9},
+		{[]int{3, 3, 3, 3}, 12},
+		{[]int{2, 1, 5, 6, 2, 3}, 10},
+		{nil, 0},
+	}
+	for _, tt := range tests {
+		if got := largestRectangleInHistogram(tt.heights); got != tt.want {
+			t.Errorf("largestRectangleInHistogram(%v) = %d, want %d", tt.heights, got, tt.want)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 500; trial++ {
+		heights := make([]int, rng.Intn(20))
+		for i := range heights {
+			heights[i] = rng.Intn(6)
+		}
+		if got, want := largestRectangleInHistogram(heights), bruteLargestRectangle(heights); got != want {
+			t.Fatalf("largestRectangleInHistogram(%v) = %d, brute force %d", heights, got, want)
+		}
+	}
+}
+
+func TestMaximalRectangle(t *testing.T) {
+	mixed := [][]int{
+		{1, 0, 1, 0, 0},
+		{1, 0, 1, 1, 1},
+		{1, 1, 1, 1, 1},
+		{1, 0, 0, 1, 0},
+	}
+	if got := maximalRectangle(mixed); got != 6 {
+		t.Errorf("mixed matrix = %d, want 6", got)
+	}
+	if got := maximalRectangle(nil); got != 0 {
+		t.Errorf("empty matrix = %d, want 0", got)
+	}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("jagged matrix did not panic")
+			}
+		}()
+		maximalRectangle([][]int{{1, 1, 1}, {1}})
+	}()
+
+	rng := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 500; trial++ {
+		matrix := make([][]int, 1+rng.Intn(6))
+		cols := 1 + rng.Intn(6)
+		for r := range matrix {
+			matrix[r] = make([]int, cols)
+			for c := range matrix[r] {
+				if rng.Intn(4) != 0 {
+					matrix[r][c] = 1
+				}
+			}
+		}
+		if got, want := maximalRectangle(matrix), bruteMaximalRectangle(matrix); got != want {
+			t.Fatalf("maximalRectangle(%v) = %d, brute force %d", matrix, got, want)
+		}
+	}
+}
@@ -0,0 +1,520 @@
+// h1 -- String Algorithms Implementation in Go
+// h2 -- Structures and techniques for substring queries
+// h2 -- Includes a suffix automaton checked against brute-force references
+// h2 -- and plain versus path-compressed tries for prefix storage
+// h2 -- Sliding-window substring problems operate on runes, so Unicode is handled
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// h3 -- Automaton State Type
+// h4 -- One equivalence class of substrings sharing the same end positions
+// h5 -- length: Length of the longest substring in the class
+// h5 -- link: Suffix link to the class of the longest shorter suffix (-1 for the root)
+// h5 -- next: Outgoing transitions keyed by byte
+type samState struct {
+	length int
+	link   int
+	next   map[byte]int
+}
+
+// h3 -- Suffix Automaton Type
+// h4 -- Minimal DFA accepting every suffix of the text; at most 2n-1 states
+type SuffixAutomaton struct {
+	states []samState
+	last   int // State reached by the whole text read so far
+}
+
+// h3 -- Suffix Automaton Constructor
+// h4 -- Builds the automaton online, one byte at a time
+// h5 -- text: Input string (processed as bytes)
+// h6 -- Time Complexity: O(n) amortized for a fixed alphabet
+func NewSuffixAutomaton(text string) *SuffixAutomaton {
+	sa := &SuffixAutomaton{
+		states: make([]samState, 1, 2*len(text)+1),
+	}
+	sa.states[0] = samState{link: -1, next: map[byte]int{}}
+	for i := 0; i < len(text); i++ {
+		sa.extend(text[i])
+	}
+	return sa
+}
+
+// h3 -- Extend Function
+// h4 -- Appends byte c, adding the new suffixes and splitting a state when needed
+func (sa *SuffixAutomaton) extend(c byte) {
+	cur := len(sa.states)
+	sa.states = append(sa.states, samState{
+		length: sa.states[sa.last].length + 1,
+		next:   map[byte]int{},
+	})
+
+	// Add transitions on c from every suffix state that lacks one
+	p := sa.last
+	for p != -1 {
+		if _, ok := sa.states[p].next[c]; ok {
+			break
+		}
+		sa.states[p].next[c] = cur
+		p = sa.states[p].link
+	}
+
+	switch {
+	case p == -1:
+		sa.states[cur].link = 0
+	case sa.states[p].length+1 == sa.states[sa.states[p].next[c]].length:
+		sa.states[cur].link = sa.states[p].next[c]
+	default:
+		// Split q: the clone keeps only the shorter substrings of q's class
+		q := sa.states[p].next[c]
+		clone := len(sa.states)
+		cloneNext := make(map[byte]int, len(sa.states[q].next))
+		for k, v := range sa.states[q].next {
+			cloneNext[k] = v
+		}
+		sa.states = append(sa.states, samState{
+			length: sa.states[p].length + 1,
+			link:   sa.states[q].link,
+			next:   cloneNext,
+		})
+		for p != -1 && sa.states[p].next[c] == q {
+			sa.states[p].next[c] = clone
+			p = sa.states[p].link
+		}
+		sa.states[q].link = clone
+		sa.states[cur].link = clone
+	}
+	sa.last = cur
+}
+
+// h3 -- Distinct Substring Count Function
+// h4 -- Each state contributes the substrings its class adds beyond its suffix link
+// h6 -- Returns: Number of distinct non-empty substrings
+// h6 -- Time Complexity: O(states)
+func (sa *SuffixAutomaton) CountDistinctSubstrings() int {
+	count := 0
+	for v := 1; v < len(sa.states); v++ {
+		count += sa.states[v].length - sa.states[sa.states[v].link].length
+	}
+	return count
+}
+
+// h3 -- Substring Membership Function
+// h4 -- Follows transitions from the root; p is a substring iff the walk never fails
+// h6 -- Time Complexity: O(|p|)
+func (sa *SuffixAutomaton) ContainsSubstring(p string) bool {
+	state := 0
+	for i := 0; i < len(p); i++ {
+		next, ok := sa.states[state].next[p[i]]
+		if !ok {
+			return false
+		}
+		state = next
+	}
+	return true
+}
+
+// h3 -- Brute-Force Distinct Substring Count
+// h4 -- Reference that collects every substring in a set
+// h6 -- Time Complexity: O(n³) including hashing; only for short strings
+func bruteDistinctSubstrings(text string) int {
+	seen := make(map[string]struct{})
+	for i := 0; i < len(text); i++ {
+		for j := i + 1; j <= len(text); j++ {
+			seen[text[i:j]] = struct{}{}
+		}
+	}
+	return len(seen)
+}
+
+// h3 -- Trie Type
+// h4 -- Plain trie with one node per byte of every stored key
+type trieNode struct {
+	children map[byte]*trieNode
+	terminal bool
+}
+
+type Trie struct {
+	root  *trieNode
+	nodes int
+}
+
+func NewTrie() *Trie {
+	return &Trie{root: &trieNode{children: map[byte]*trieNode{}}, nodes: 1}
+}
+
+// h3 -- Trie Insert Function
+// h6 -- Time Complexity: O(|key|)
+func (t *Trie) Insert(key string) {
+	node := t.root
+	for i := 0; i < len(key); i++ {
+		child, ok := node.children[key[i]]
+		if !ok {
+			child = &trieNode{children: map[byte]*trieNode{}}
+			node.children[key[i]] = child
+			t.nodes++
+		}
+		node = child
+	}
+	node.terminal = true
+}
+
+func (t *Trie) Contains(key string) bool {
+	node := t.root
+	for i := 0; i < len(key); i++ {
+		node = node.children[key[i]]
+		if node == nil {
+			return false
+		}
+	}
+	return node.terminal
+}
+
+func (t *Trie) NodeCount() int { return t.nodes }
+
+// h3 -- Radix Tree Node Type
+// h4 -- Edge into the node is labeled with a whole substring rather than one byte
+// h5 -- children: Keyed by the first byte of each child's label (labels of siblings never share it)
+type radixNode struct {
+	label    string
+	children map[byte]*radixNode
+	terminal bool
+}
+
+func newRadixNode(label string, terminal bool) *radixNode {
+	return &radixNode{label: label, children: map[byte]*radixNode{}, terminal: terminal}
+}
+
+// h3 -- Radix Tree Type (Patricia Trie)
+// h4 -- Trie whose chains of single-child nodes are merged into one labeled edge
+// h6 -- Every non-root node is either terminal or has at least two children,
+// h6 -- so the node count is O(number of keys) instead of O(total key length)
+type RadixTree struct {
+	root  *radixNode
+	nodes int
+}
+
+func NewRadixTree() *RadixTree {
+	return &RadixTree{root: newRadixNode("", false), nodes: 1}
+}
+
+// h3 -- Common Prefix Length Helper
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// h3 -- Radix Tree Insert Function
+// h4 -- Follows matching edges, splitting an edge where the key diverges mid-label
+// h6 -- Returns: true if key was not already stored
+// h6 -- Time Complexity: O(|key|)
+func (rt *RadixTree) Insert(key string) bool {
+	node := rt.root
+	for {
+		if key == "" {
+			added := !node.terminal
+			node.terminal = true
+			return added
+		}
+
+		child, ok := node.children[key[0]]
+		if !ok {
+			node.children[key[0]] = newRadixNode(key, true)
+			rt.nodes++
+			return true
+		}
+
+		common := commonPrefixLen(child.label, key)
+		if common < len(child.label) {
+			// Split the edge at the divergence point, e.g. "test" + "team" -> "te" -> {"st", "am"}
+			split := newRadixNode(child.label[:common], false)
+			child.label = child.label[common:]
+			split.children[child.label[0]] = child
+			node.children[key[0]] = split
+			rt.nodes++
+			child = split
+		}
+		node = child
+		key = key[common:]
+	}
+}
+
+// h3 -- Radix Tree Lookup Function
+// h6 -- Time Complexity: O(|key|)
+func (rt *RadixTree) Contains(key string) bool {
+	node := rt.root
+	for key != "" {
+		child, ok := node.children[key[0]]
+		if !ok || !strings.HasPrefix(key, child.label) {
+			return false
+		}
+		node = child
+		key = key[len(child.label):]
+	}
+	return node.terminal
+}
+
+// h3 -- Prefix Iteration Function
+// h4 -- Descends to the node covering prefix (possibly mid-edge) and lists the keys below it
+// h6 -- Returns: Stored keys starting with prefix, in lexicographic order
+// h6 -- Time Complexity: O(|prefix| + size of the output)
+func (rt *RadixTree) KeysWithPrefix(prefix string) []string {
+	node := rt.root
+	path := ""
+	for rest := prefix; rest != ""; {
+		child, ok := node.children[rest[0]]
+		if !ok {
+			return nil
+		}
+		common := commonPrefixLen(child.label, rest)
+		if common < len(rest) && common < len(child.label) {
+			return nil // Diverges inside the edge label
+		}
+		path += child.label
+		node = child
+		rest = rest[common:]
+	}
+
+	var keys []string
+	var collect func(n *radixNode, path string)
+	collect = func(n *radixNode, path string) {
+		if n.terminal {
+			keys = append(keys, path)
+		}
+		firsts := make([]byte, 0, len(n.children))
+		for b := range n.children {
+			firsts = append(firsts, b)
+		}
+		sort.Slice(firsts, func(i, j int) bool { return firsts[i] < firsts[j] })
+		for _, b := range firsts {
+			child := n.children[b]
+			collect(child, path+child.label)
+		}
+	}
+	collect(node, path)
+	return keys
+}
+
+func (rt *RadixTree) NodeCount() int { return rt.nodes }
+
+// h3 -- Longest Unique Substring Function
+// h4 -- Sliding window that jumps its left edge past the previous copy of a repeated rune
+// h5 -- runes: Input decoded to runes, so multi-byte characters count once
+// h6 -- Returns: Rune index where the longest repeat-free substring starts, and its length
+// h6 --          (the earliest one on ties)
+// h6 -- Time Complexity: O(n), Space Complexity: O(distinct runes)
+func longestUniqueWindow(runes []rune) (start, length int) {
+	lastSeen := make(map[rune]int) // Most recent index of each rune
+	left := 0
+	for right, r := range runes {
+		if prev, ok := lastSeen[r]; ok && prev >= left {
+			left = prev + 1 // Drop everything up to the earlier copy
+		}
+		lastSeen[r] = right
+		if right-left+1 > length {
+			start, length = left, right-left+1
+		}
+	}
+	return start, length
+}
+
+// h3 -- Longest Substring Length Function
+// h6 -- Returns: Length in runes of the longest substring without repeated characters
+func lengthOfLongestSubstring(s string) int {
+	_, length := longestUniqueWindow([]rune(s))
+	return length
+}
+
+// h3 -- Longest Substring Function
+// h6 -- Returns: The first longest substring without repeated characters
+func longestSubstringWithoutRepeats(s string) string {
+	runes := []rune(s)
+	start, length := longestUniqueWindow(runes)
+	return string(runes[start : start+length])
+}
+
+// h3 -- Brute-Force Longest Unique Substring
+// h4 -- Reference that extends every start position until a repeat appears
+// h6 -- Time Complexity: O(n²)
+func bruteLongestUnique(s string) int {
+	runes := []rune(s)
+	best := 0
+	for i := range runes {
+		seen := make(map[rune]bool)
+		for j := i; j < len(runes) && !seen[runes[j]]; j++ {
+			seen[runes[j]] = true
+			best = max(best, j-i+1)
+		}
+	}
+	return best
+}
+
+// h3 -- Minimum Window Substring Function
+// h4 -- Expands right until every required rune is covered, then shrinks left while it stays covered
+// h5 -- s: Text to search (as runes)
+// h5 -- t: Required characters, with multiplicity
+// h6 -- Returns: Shortest substring of s containing all of t (earliest on ties),
+// h6 --          or "" when none exists (including t longer than s); "" for an empty t
+// h6 -- Time Complexity: O(|s| + |t|), Space Complexity: O(distinct runes of t)
+func minWindow(s, t string) string {
+	text, pattern := []rune(s), []rune(t)
+	if len(pattern) == 0 || len(pattern) > len(text) {
+		return ""
+	}
+
+	need := make(map[rune]int)
+	for _, r := range pattern {
+		need[r]++
+	}
+	missing := len(pattern) // Required runes not yet inside the window
+
+	bestStart, bestLen := 0, -1
+	left := 0
+	for right, r := range text {
+		if need[r] > 0 {
+			missing--
+		}
+		need[r]-- // Negative counts mark surplus copies inside the window
+
+		for missing == 0 {
+			if bestLen < 0 || right-left+1 < bestLen {
+				bestStart, bestLen = left, right-left+1
+			}
+			need[text[left]]++
+			if need[text[left]] > 0 {
+				missing++ // Dropped a copy the window needed
+			}
+			left++
+		}
+	}
+	if bestLen < 0 {
+		return ""
+	}
+	return string(text[bestStart : bestStart+bestLen])
+}
+
+// h3 -- Brute-Force Minimum Window
+// h4 -- Reference that tests every substring in order of length
+// h6 -- Time Complexity: O(n³)
+func bruteMinWindow(s, t string) string {
+	text, pattern := []rune(s), []rune(t)
+	if len(pattern) == 0 {
+		return ""
+	}
+	covers := func(window []rune) bool {
+		counts := make(map[rune]int)
+		for _, r := range window {
+			counts[r]++
+		}
+		for _, r := range pattern {
+			counts[r]--
+			if counts[r] < 0 {
+				return false
+			}
+		}
+		return true
+	}
+	for length := len(pattern); length <= len(text); length++ {
+		for start := 0; start+length <= len(text); start++ {
+			if covers(text[start : start+length]) {
+				return string(text[start : start+length])
+			}
+		}
+	}
+	return ""
+}
+
+func main() {
+	fmt.Println("=== STRING ALGORITHMS - GO IMPLEMENTATION ===")
+	fmt.Println()
+
+	// h3 -- Suffix Automaton
+	// h4 -- Distinct substring counts and membership versus brute force
+	fmt.Println("1. SUFFIX AUTOMATON")
+	fmt.Println("===================")
+
+	for _, text := range []string{"", "a", "aaaa", "abab", "banana", "mississippi"} {
+		sa := NewSuffixAutomaton(text)
+		fmt.Printf("  %-13q states: %2d, distinct substrings: %2d (brute force: %2d)\n",
+			text, len(sa.states), sa.CountDistinctSubstrings(), bruteDistinctSubstrings(text))
+	}
+
+	text := "mississippi"
+	sa := NewSuffixAutomaton(text)
+	fmt.Printf("\n  Membership in %q:\n", text)
+	for _, p := range []string{"ssi", "issip", "sis", "ppi", "pis", "", "mississippi!"} {
+		fmt.Printf("    %-14q automaton: %-5t strings.Contains: %t\n",
+			p, sa.ContainsSubstring(p), strings.Contains(text, p))
+	}
+
+	// h3 -- Radix Tree
+	// h4 -- Same keys as a plain trie with far fewer nodes, edge splits and prefix queries
+	fmt.Println("\n2. RADIX TREE")
+	fmt.Println("=============")
+
+	keys := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "rom"}
+	trie, rt := NewTrie(), NewRadixTree()
+	for _, k := range keys {
+		trie.Insert(k)
+		rt.Insert(k)
+	}
+	fmt.Printf("  Keys: %v\n", keys)
+	fmt.Printf("  Trie nodes: %d, radix tree nodes: %d\n", trie.NodeCount(), rt.NodeCount())
+	fmt.Printf("  Re-inserting %q adds a key: %t\n", "ruber", rt.Insert("ruber"))
+
+	mismatches := 0
+	probes := append(slices.Clone(keys), "", "r", "ro", "roma", "romanes", "rub", "rubi", "x")
+	for _, p := range probes {
+		if trie.Contains(p) != rt.Contains(p) {
+			mismatches++
+		}
+	}
+	fmt.Printf("  Contains mismatches against trie over %d probes: %d\n", len(probes), mismatches)
+
+	// Inserting "test" then "team" splits the "test" edge at "te"
+	split := NewRadixTree()
+	split.Insert("test")
+	split.Insert("team")
+	fmt.Printf("  After %q, %q: root edges %d, nodes %d, edge %q\n",
+		"test", "team", len(split.root.children), split.NodeCount(), split.root.children['t'].label)
+
+	for _, p := range []string{"rom", "rub", "rubic", "ruben", "roma", "z", ""} {
+		fmt.Printf("    prefix %-7q -> %v\n", p, rt.KeysWithPrefix(p))
+	}
+
+	// h3 -- Longest Substring Without Repeats
+	// h4 -- All-unique, all-same, mixed and multi-byte inputs versus brute force
+	fmt.Println("\n3. LONGEST SUBSTRING WITHOUT REPEATS")
+	fmt.Println("====================================")
+	for _, s := range []string{"", "abcdef", "aaaa", "abcabcbb", "pwwkew", "dvdf", "abba", "日本語日本", "héllo wörld"} {
+		fmt.Printf("  %-15q length %d %-12q (brute force: %d)\n",
+			s, lengthOfLongestSubstring(s), longestSubstringWithoutRepeats(s), bruteLongestUnique(s))
+	}
+
+	// h3 -- Minimum Window Substring
+	// h4 -- Repeated required characters, no-solution and oversized patterns
+	fmt.Println("\n4. MINIMUM WINDOW SUBSTRING")
+	fmt.Println("===========================")
+	windowCases := [][2]string{
+		{"ADOBECODEBANC", "ABC"},
+		{"ADOBECODEBANC", "AABC"}, // Needs both A's
+		{"aa", "aa"},
+		{"abcabdebac", "cea"},
+		{"a", "b"},  // No solution
+		{"a", "aa"}, // Pattern longer than text
+		{"ñandú ñu", "ññ"},
+	}
+	for _, c := range windowCases {
+		fmt.Printf("  minWindow(%q, %q) = %q (brute force: %q)\n",
+			c[0], c[1], minWindow(c[0], c[1]), bruteMinWindow(c[0], c[1]))
+	}
+}